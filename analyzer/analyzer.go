@@ -0,0 +1,292 @@
+// Package analyzer provides a go/analysis analyzer that flags unchecked integer conversions
+// which can silently overflow or change sign, such as uint32(x) where x is an int64, and
+// suggests the matching checked function from the safeconversion package.
+//
+// Each diagnostic with a matching function carries a suggested fix, so a code base can be
+// migrated with safeconvcheck -fix. A conversion assigned with := in a function that returns
+// an error is rewritten to the checked call followed by an error return, and any other
+// conversion is wrapped in safeconversion.Must.
+//
+// A conversion can be excluded by placing a //safeconv:ignore comment on the same line or
+// on the line above it.
+package analyzer
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+// ignoreDirective excludes a conversion from analysis when placed on its line or the line above.
+const ignoreDirective = "//safeconv:ignore"
+
+// packagePath is the import path of the safeconversion package, whose own conversions and
+// tests are not analyzed.
+const packagePath = "github.com/bsv-blockchain/go-safe-conversion"
+
+// Analyzer flags unchecked narrowing and sign-changing integer conversions.
+//
+//nolint:gochecknoglobals // analyzers are exported as package-level values by convention
+var Analyzer = &analysis.Analyzer{
+	Name:     "safeconv",
+	Doc:      "flag integer conversions that can overflow or change sign, and suggest the matching safeconversion function",
+	URL:      "https://pkg.go.dev/" + packagePath + "/analyzer",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+// integer describes an integer type for the purpose of range comparison.
+type integer struct {
+	name    string
+	kind    types.BasicKind
+	signed  bool
+	minBits int
+	maxBits int
+
+	// exact is set when the type is the predeclared type or big.Word itself rather than
+	// a type defined on top of it, so that values can be passed to the suggested function.
+	exact bool
+}
+
+// functions maps a source and destination type name to the safeconversion function that checks it.
+func functions() map[[2]string]string {
+	suggestions := generatedFunctions()
+	for types, fn := range handWrittenFunctions() {
+		suggestions[types] = fn
+	}
+
+	return suggestions
+}
+
+// handWrittenFunctions maps a source and destination type name to the hand-written
+// safeconversion function that checks it.
+func handWrittenFunctions() map[[2]string]string {
+	return map[[2]string]string{
+		{"int", "uint32"}:      "IntToUint32",
+		{"uint64", "uint32"}:   "Uint64ToUint32",
+		{"int64", "uint64"}:    "Int64ToUint64",
+		{"int", "uint64"}:      "IntToUint64",
+		{"uint64", "int"}:      "Uint64ToInt",
+		{"int64", "int32"}:     "Int64ToInt32",
+		{"int", "int32"}:       "IntToInt32",
+		{"int32", "uint32"}:    "Int32ToUint32",
+		{"int64", "uint32"}:    "Int64ToUint32",
+		{"big.Word", "uint32"}: "BigWordToUint32",
+		{"int", "uint16"}:      "IntToUint16",
+		{"int", "int16"}:       "IntToInt16",
+		{"uint", "uint32"}:     "UintToUint32",
+		{"uint32", "uint8"}:    "Uint32ToUint8",
+		{"uintptr", "int"}:     "UintptrToInt",
+		{"int", "uintptr"}:     "IntToUintptr",
+		{"int64", "uintptr"}:   "Int64ToUintptr",
+		{"uint64", "uintptr"}:  "Uint64ToUintptr",
+		{"uint64", "int64"}:    "Uint64ToInt64",
+		{"uint32", "int32"}:    "Uint32ToInt32",
+		{"uint64", "int32"}:    "Uint64ToInt32",
+		{"uint64", "uint16"}:   "Uint64ToUint16",
+		{"int", "byte"}:        "IntToByte",
+		{"int", "uint8"}:       "IntToByte",
+		{"int", "rune"}:        "IntToRune",
+		{"uint32", "rune"}:     "Uint32ToRune",
+	}
+}
+
+// run reports the unchecked conversions of a package.
+func run(pass *analysis.Pass) (any, error) {
+	if strings.TrimSuffix(pass.Pkg.Path(), "_test") != packagePath {
+		check(pass)
+	}
+
+	return nil, nil //nolint:nilnil // the analyzer reports diagnostics only and has no result
+}
+
+// check reports every unchecked conversion in the files of pass.
+func check(pass *analysis.Pass) {
+	insp, ok := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	if !ok {
+		return
+	}
+
+	ignored := ignoredLines(pass)
+	suggestions := functions()
+
+	insp.WithStack([]ast.Node{(*ast.CallExpr)(nil)}, func(n ast.Node, push bool, stack []ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if push && ok && len(call.Args) == 1 {
+			report(pass, call, stack, ignored, suggestions)
+		}
+
+		return true
+	})
+}
+
+// report reports call if it is an unchecked conversion, together with a fix that rewrites it
+// to the matching safeconversion function when one exists.
+func report(pass *analysis.Pass, call *ast.CallExpr, stack []ast.Node, ignored map[lineKey]bool, suggestions map[[2]string]string) {
+	dst, ok := conversionTarget(pass, call)
+	if !ok {
+		return
+	}
+
+	arg := call.Args[0]
+	if tv, found := pass.TypesInfo.Types[arg]; !found || tv.Value != nil {
+		return
+	}
+
+	src, ok := integerOf(pass.TypesInfo.TypeOf(arg))
+	if !ok || fits(src, dst) {
+		return
+	}
+
+	position := pass.Fset.Position(call.Pos())
+	if ignored[lineKey{position.Filename, position.Line}] || ignored[lineKey{position.Filename, position.Line - 1}] {
+		return
+	}
+
+	fn, found := suggestion(suggestions, src, dst)
+	if !found {
+		pass.Reportf(call.Pos(), "unchecked conversion from %s to %s may overflow", src.name, dst.name)
+		return
+	}
+
+	diagnostic := analysis.Diagnostic{
+		Pos:     call.Pos(),
+		End:     call.End(),
+		Message: fmt.Sprintf("unchecked conversion from %s to %s may overflow; use safeconversion.%s", src.name, dst.name, fn),
+	}
+
+	if src.exact && dst.exact {
+		diagnostic.SuggestedFixes = []analysis.SuggestedFix{suggestFix(pass, call, stack, fn)}
+	}
+
+	pass.Report(diagnostic)
+}
+
+// suggestion returns the safeconversion function converting src to dst. Conversions written with
+// the byte and rune aliases fall back to the function for the underlying uint8 or int32 type.
+func suggestion(suggestions map[[2]string]string, src, dst integer) (string, bool) {
+	if fn, found := suggestions[[2]string{src.name, dst.name}]; found {
+		return fn, true
+	}
+
+	fn, found := suggestions[[2]string{canonicalName(src), canonicalName(dst)}]
+
+	return fn, found
+}
+
+// canonicalName returns the name of the predeclared type of t, such as uint8 for byte, or the
+// name of t when it is not predeclared.
+func canonicalName(t integer) string {
+	if t.name == "big.Word" {
+		return t.name
+	}
+
+	return types.Typ[t.kind].Name()
+}
+
+// lineKey identifies a line of a source file.
+type lineKey struct {
+	filename string
+	line     int
+}
+
+// ignoredLines returns the lines that carry an ignore directive.
+func ignoredLines(pass *analysis.Pass) map[lineKey]bool {
+	ignored := make(map[lineKey]bool)
+
+	for _, file := range pass.Files {
+		for _, group := range file.Comments {
+			for _, comment := range group.List {
+				if strings.HasPrefix(comment.Text, ignoreDirective) {
+					position := pass.Fset.Position(comment.Slash)
+					ignored[lineKey{position.Filename, position.Line}] = true
+				}
+			}
+		}
+	}
+
+	return ignored
+}
+
+// conversionTarget returns the destination integer type when call is a type conversion.
+// The byte and rune aliases are reported by their alias name.
+func conversionTarget(pass *analysis.Pass, call *ast.CallExpr) (integer, bool) {
+	tv, found := pass.TypesInfo.Types[call.Fun]
+	if !found || !tv.IsType() {
+		return integer{}, false
+	}
+
+	dst, ok := integerOf(tv.Type)
+	if !ok {
+		return integer{}, false
+	}
+
+	if ident, isIdent := ast.Unparen(call.Fun).(*ast.Ident); isIdent && (ident.Name == "byte" || ident.Name == "rune") {
+		if obj, isTypeName := pass.TypesInfo.Uses[ident].(*types.TypeName); isTypeName && obj.Pkg() == nil {
+			dst.name = ident.Name
+		}
+	}
+
+	return dst, true
+}
+
+// integerOf describes t when its underlying type is an integer type.
+func integerOf(t types.Type) (integer, bool) {
+	if t == nil {
+		return integer{}, false
+	}
+
+	basic, ok := t.Underlying().(*types.Basic)
+	if !ok || basic.Info()&types.IsInteger == 0 {
+		return integer{}, false
+	}
+
+	name := basic.Name()
+	if named, isNamed := types.Unalias(t).(*types.Named); isNamed {
+		if obj := named.Obj(); obj.Pkg() != nil && obj.Pkg().Path() == "math/big" && obj.Name() == "Word" {
+			name = "big.Word"
+		}
+	}
+
+	signed := basic.Info()&types.IsUnsigned == 0
+	_, exact := types.Unalias(t).(*types.Basic)
+	exact = exact || name == "big.Word"
+
+	switch basic.Kind() {
+	case types.Int8, types.Uint8:
+		return integer{name, basic.Kind(), signed, 8, 8, exact}, true
+	case types.Int16, types.Uint16:
+		return integer{name, basic.Kind(), signed, 16, 16, exact}, true
+	case types.Int32, types.Uint32:
+		return integer{name, basic.Kind(), signed, 32, 32, exact}, true
+	case types.Int64, types.Uint64:
+		return integer{name, basic.Kind(), signed, 64, 64, exact}, true
+	case types.Int, types.Uint, types.Uintptr:
+		return integer{name, basic.Kind(), signed, 32, 64, exact}, true
+	default:
+		return integer{}, false
+	}
+}
+
+// fits reports whether every value of src is representable in dst on every platform.
+// Platform-dependent types are assumed to be as wide as possible when converting from
+// them and as narrow as possible when converting to them.
+func fits(src, dst integer) bool {
+	if src.kind == dst.kind {
+		return true
+	}
+
+	switch {
+	case src.signed == dst.signed:
+		return src.maxBits <= dst.minBits
+	case dst.signed:
+		return src.maxBits < dst.minBits
+	default:
+		return false
+	}
+}
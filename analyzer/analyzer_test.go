@@ -0,0 +1,19 @@
+package analyzer_test
+
+import (
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+
+	"github.com/bsv-blockchain/go-safe-conversion/analyzer"
+)
+
+// TestAnalyzer tests the analyzer against the annotated files in testdata.
+func TestAnalyzer(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), analyzer.Analyzer, "a")
+}
+
+// TestAnalyzerSuggestedFixes tests the fixes against the golden files in testdata.
+func TestAnalyzerSuggestedFixes(t *testing.T) {
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), analyzer.Analyzer, "fix", "aliased")
+}
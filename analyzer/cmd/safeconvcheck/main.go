@@ -0,0 +1,23 @@
+// Package main provides safeconvcheck, a command that reports integer conversions which can
+// overflow or change sign and suggests the matching safeconversion function.
+//
+// The command lives in the analyzer module, so that the safeconversion package itself has no
+// dependency on golang.org/x/tools. Install it with:
+//
+//	go install github.com/bsv-blockchain/go-safe-conversion/analyzer/cmd/safeconvcheck@latest
+//
+// Usage:
+//
+//	safeconvcheck ./...
+package main
+
+import (
+	"golang.org/x/tools/go/analysis/singlechecker"
+
+	"github.com/bsv-blockchain/go-safe-conversion/analyzer"
+)
+
+// main runs the safeconv analyzer as a standalone command.
+func main() {
+	singlechecker.Main(analyzer.Analyzer)
+}
@@ -0,0 +1,193 @@
+package analyzer
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// packageName is the name of the safeconversion package, used when the fix adds its import.
+const packageName = "safeconversion"
+
+// suggestFix returns a fix that rewrites the conversion call to the safeconversion function fn.
+// A conversion assigned with := in a function that returns an error becomes a checked call
+// followed by an error return, and any other conversion is wrapped in safeconversion.Must.
+func suggestFix(pass *analysis.Pass, call *ast.CallExpr, stack []ast.Node, fn string) analysis.SuggestedFix {
+	name, importEdits := importPackage(stack)
+	qualified := name + "." + fn
+
+	if edits, ok := errorReturnEdits(pass, call, stack, qualified); ok {
+		return analysis.SuggestedFix{
+			Message:   "Use " + qualified + " and return its error",
+			TextEdits: append(edits, importEdits...),
+		}
+	}
+
+	edits := []analysis.TextEdit{
+		{Pos: call.Pos(), End: call.Lparen, NewText: []byte(name + ".Must(" + qualified)},
+		{Pos: call.End(), End: call.End(), NewText: []byte(")")},
+	}
+
+	return analysis.SuggestedFix{
+		Message:   "Use " + name + ".Must(" + qualified + ")",
+		TextEdits: append(edits, importEdits...),
+	}
+}
+
+// errorReturnEdits returns the edits that turn "x := T(v)" into a checked call followed by
+// an error return. It reports false if the conversion is not the whole right-hand side of
+// a single := assignment in a block, or if the enclosing function does not return an error.
+func errorReturnEdits(pass *analysis.Pass, call *ast.CallExpr, stack []ast.Node, qualified string) ([]analysis.TextEdit, bool) {
+	if len(stack) < 3 {
+		return nil, false
+	}
+
+	assign, ok := stack[len(stack)-2].(*ast.AssignStmt)
+	if !ok || assign.Tok != token.DEFINE || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 || assign.Rhs[0] != call {
+		return nil, false
+	}
+
+	switch stack[len(stack)-3].(type) {
+	case *ast.BlockStmt, *ast.CaseClause, *ast.CommClause:
+	default:
+		return nil, false
+	}
+
+	results := enclosingResults(pass, stack)
+	if results == nil || results.Len() == 0 || !isError(results.At(results.Len()-1).Type()) {
+		return nil, false
+	}
+
+	values := make([]string, 0, results.Len())
+	for i := range results.Len() - 1 {
+		values = append(values, zeroValue(pass, results.At(i).Type()))
+	}
+
+	values = append(values, "err")
+
+	// The check goes at the end of the line, so that a trailing comment stays with the assignment.
+	tokenFile := pass.Fset.File(assign.Pos())
+	line := tokenFile.Line(assign.End())
+	if line >= tokenFile.LineCount() {
+		return nil, false
+	}
+
+	lineEnd := tokenFile.LineStart(line+1) - 1
+	indent := strings.Repeat("\t", pass.Fset.Position(assign.Pos()).Column-1)
+	check := "\n" + indent + "if err != nil {\n" + indent + "\treturn " + strings.Join(values, ", ") + "\n" + indent + "}"
+
+	return []analysis.TextEdit{
+		{Pos: assign.Lhs[0].End(), End: assign.Lhs[0].End(), NewText: []byte(", err")},
+		{Pos: call.Pos(), End: call.Lparen, NewText: []byte(qualified)},
+		{Pos: lineEnd, End: lineEnd, NewText: []byte(check)},
+	}, true
+}
+
+// enclosingResults returns the results of the innermost function in stack, or nil if there is none.
+func enclosingResults(pass *analysis.Pass, stack []ast.Node) *types.Tuple {
+	for i := len(stack) - 1; i >= 0; i-- {
+		var t types.Type
+
+		switch fn := stack[i].(type) {
+		case *ast.FuncLit:
+			t = pass.TypesInfo.TypeOf(fn)
+		case *ast.FuncDecl:
+			if obj := pass.TypesInfo.Defs[fn.Name]; obj != nil {
+				t = obj.Type()
+			}
+		default:
+			continue
+		}
+
+		if sig, ok := t.(*types.Signature); ok {
+			return sig.Results()
+		}
+
+		return nil
+	}
+
+	return nil
+}
+
+// isError reports whether t is the predeclared error type.
+func isError(t types.Type) bool {
+	return types.Identical(t, types.Universe.Lookup("error").Type())
+}
+
+// zeroValue returns an expression for the zero value of t.
+func zeroValue(pass *analysis.Pass, t types.Type) string {
+	if _, ok := types.Unalias(t).(*types.TypeParam); ok {
+		return "*new(" + types.TypeString(t, qualifier(pass)) + ")"
+	}
+
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		switch {
+		case u.Info()&types.IsBoolean != 0:
+			return "false"
+		case u.Info()&types.IsString != 0:
+			return `""`
+		case u.Kind() == types.UnsafePointer:
+			return "nil"
+		default:
+			return "0"
+		}
+	case *types.Struct, *types.Array:
+		return types.TypeString(t, qualifier(pass)) + "{}"
+	default:
+		return "nil"
+	}
+}
+
+// qualifier qualifies types from other packages by their package name.
+func qualifier(pass *analysis.Pass) types.Qualifier {
+	return func(pkg *types.Package) string {
+		if pkg == pass.Pkg {
+			return ""
+		}
+
+		return pkg.Name()
+	}
+}
+
+// importPackage returns the name under which the file in stack imports the safeconversion
+// package, and the edits that add the import when the file does not import it yet.
+func importPackage(stack []ast.Node) (string, []analysis.TextEdit) {
+	file, ok := stack[0].(*ast.File)
+	if !ok {
+		return packageName, nil
+	}
+
+	for _, spec := range file.Imports {
+		if path, err := strconv.Unquote(spec.Path.Value); err != nil || path != packagePath {
+			continue
+		}
+
+		if spec.Name != nil {
+			return spec.Name.Name, nil
+		}
+
+		return packageName, nil
+	}
+
+	line := packageName + " " + strconv.Quote(packagePath)
+
+	for _, decl := range file.Decls {
+		gen, isGen := decl.(*ast.GenDecl)
+		if !isGen || gen.Tok != token.IMPORT {
+			continue
+		}
+
+		if gen.Rparen.IsValid() {
+			return packageName, []analysis.TextEdit{{Pos: gen.Rparen, End: gen.Rparen, NewText: []byte("\n\t" + line + "\n")}}
+		}
+
+		return packageName, []analysis.TextEdit{{Pos: gen.End(), End: gen.End(), NewText: []byte("\n\nimport " + line)}}
+	}
+
+	return packageName, []analysis.TextEdit{{Pos: file.Name.End(), End: file.Name.End(), NewText: []byte("\n\nimport " + line)}}
+}
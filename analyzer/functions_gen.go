@@ -0,0 +1,67 @@
+// Code generated by gensafeconv. DO NOT EDIT.
+
+package analyzer
+
+// generatedFunctions maps a source and destination type name to the generated
+// safeconversion function that checks it.
+func generatedFunctions() map[[2]string]string {
+	return map[[2]string]string{
+		{"int", "int8"}:       "IntToInt8",
+		{"int", "uint"}:       "IntToUint",
+		{"int", "uint8"}:      "IntToUint8",
+		{"int8", "uint"}:      "Int8ToUint",
+		{"int8", "uint8"}:     "Int8ToUint8",
+		{"int8", "uint16"}:    "Int8ToUint16",
+		{"int8", "uint32"}:    "Int8ToUint32",
+		{"int8", "uint64"}:    "Int8ToUint64",
+		{"int8", "uintptr"}:   "Int8ToUintptr",
+		{"int16", "int8"}:     "Int16ToInt8",
+		{"int16", "uint"}:     "Int16ToUint",
+		{"int16", "uint8"}:    "Int16ToUint8",
+		{"int16", "uint16"}:   "Int16ToUint16",
+		{"int16", "uint32"}:   "Int16ToUint32",
+		{"int16", "uint64"}:   "Int16ToUint64",
+		{"int16", "uintptr"}:  "Int16ToUintptr",
+		{"int32", "int8"}:     "Int32ToInt8",
+		{"int32", "int16"}:    "Int32ToInt16",
+		{"int32", "uint"}:     "Int32ToUint",
+		{"int32", "uint8"}:    "Int32ToUint8",
+		{"int32", "uint16"}:   "Int32ToUint16",
+		{"int32", "uint64"}:   "Int32ToUint64",
+		{"int32", "uintptr"}:  "Int32ToUintptr",
+		{"int64", "int"}:      "Int64ToInt",
+		{"int64", "int8"}:     "Int64ToInt8",
+		{"int64", "int16"}:    "Int64ToInt16",
+		{"int64", "uint"}:     "Int64ToUint",
+		{"int64", "uint8"}:    "Int64ToUint8",
+		{"int64", "uint16"}:   "Int64ToUint16",
+		{"uint", "int"}:       "UintToInt",
+		{"uint", "int8"}:      "UintToInt8",
+		{"uint", "int16"}:     "UintToInt16",
+		{"uint", "int32"}:     "UintToInt32",
+		{"uint", "int64"}:     "UintToInt64",
+		{"uint", "uint8"}:     "UintToUint8",
+		{"uint", "uint16"}:    "UintToUint16",
+		{"uint", "uintptr"}:   "UintToUintptr",
+		{"uint8", "int8"}:     "Uint8ToInt8",
+		{"uint16", "int8"}:    "Uint16ToInt8",
+		{"uint16", "int16"}:   "Uint16ToInt16",
+		{"uint16", "uint8"}:   "Uint16ToUint8",
+		{"uint32", "int"}:     "Uint32ToInt",
+		{"uint32", "int8"}:    "Uint32ToInt8",
+		{"uint32", "int16"}:   "Uint32ToInt16",
+		{"uint32", "uint16"}:  "Uint32ToUint16",
+		{"uint64", "int8"}:    "Uint64ToInt8",
+		{"uint64", "int16"}:   "Uint64ToInt16",
+		{"uint64", "uint"}:    "Uint64ToUint",
+		{"uint64", "uint8"}:   "Uint64ToUint8",
+		{"uintptr", "int8"}:   "UintptrToInt8",
+		{"uintptr", "int16"}:  "UintptrToInt16",
+		{"uintptr", "int32"}:  "UintptrToInt32",
+		{"uintptr", "int64"}:  "UintptrToInt64",
+		{"uintptr", "uint"}:   "UintptrToUint",
+		{"uintptr", "uint8"}:  "UintptrToUint8",
+		{"uintptr", "uint16"}: "UintptrToUint16",
+		{"uintptr", "uint32"}: "UintptrToUint32",
+	}
+}
@@ -0,0 +1,49 @@
+package a
+
+import "math/big"
+
+type Height int64
+
+func conversions(i int, i64 int64, u uint, u32 uint32, u64 uint64, p uintptr, w big.Word, h Height, i8 int8, b byte) {
+	_ = uint32(i)   // want `unchecked conversion from int to uint32 may overflow; use safeconversion.IntToUint32`
+	_ = int32(i64)  // want `unchecked conversion from int64 to int32 may overflow; use safeconversion.Int64ToInt32`
+	_ = uint32(u64) // want `unchecked conversion from uint64 to uint32 may overflow; use safeconversion.Uint64ToUint32`
+	_ = int(u64)    // want `unchecked conversion from uint64 to int may overflow; use safeconversion.Uint64ToInt`
+	_ = int(i64)    // want `unchecked conversion from int64 to int may overflow; use safeconversion.Int64ToInt`
+	_ = uint32(w)   // want `unchecked conversion from big.Word to uint32 may overflow; use safeconversion.BigWordToUint32`
+	_ = uint32(h)   // want `unchecked conversion from int64 to uint32 may overflow; use safeconversion.Int64ToUint32`
+	_ = byte(i)     // want `unchecked conversion from int to byte may overflow; use safeconversion.IntToByte`
+	_ = rune(u32)   // want `unchecked conversion from uint32 to rune may overflow; use safeconversion.Uint32ToRune`
+	_ = int32(u32)  // want `unchecked conversion from uint32 to int32 may overflow; use safeconversion.Uint32ToInt32`
+	_ = int(p)      // want `unchecked conversion from uintptr to int may overflow; use safeconversion.UintptrToInt`
+	_ = uint8(i8)   // want `unchecked conversion from int8 to uint8 may overflow; use safeconversion.Int8ToUint8`
+	_ = uint(u64)   // want `unchecked conversion from uint64 to uint may overflow; use safeconversion.Uint64ToUint`
+	_ = rune(i64)   // want `unchecked conversion from int64 to rune may overflow; use safeconversion.Int64ToInt32`
+	_ = uint16(w)   // want `^unchecked conversion from big.Word to uint16 may overflow$`
+	_ = byte(u32)   // want `unchecked conversion from uint32 to byte may overflow; use safeconversion.Uint32ToUint8`
+	_ = byte(u64)   // want `unchecked conversion from uint64 to byte may overflow; use safeconversion.Uint64ToUint8`
+	_ = byte(i64)   // want `unchecked conversion from int64 to byte may overflow; use safeconversion.Int64ToUint8`
+	_ = rune(u64)   // want `unchecked conversion from uint64 to rune may overflow; use safeconversion.Uint64ToInt32`
+	_ = int8(b)     // want `unchecked conversion from byte to int8 may overflow; use safeconversion.Uint8ToInt8`
+}
+
+func safeConversions(i int, i32 int32, u uint, u32 uint32, w big.Word, i8 int8) {
+	_ = int64(i)
+	_ = int64(i32)
+	_ = uint64(u32)
+	_ = int64(u32)
+	_ = uint64(u)
+	_ = int(i32)
+	_ = int16(i8)
+	_ = uint(w)
+	_ = uint32(42)
+	_ = float64(i)
+	_ = Height(i32)
+}
+
+func ignored(i64 int64) {
+	_ = int32(i64) //safeconv:ignore
+
+	//safeconv:ignore checked by the caller
+	_ = uint32(i64)
+}
@@ -0,0 +1,9 @@
+package aliased
+
+import safe "github.com/bsv-blockchain/go-safe-conversion"
+
+func convert(v int64) (uint64, int32) {
+	u, _ := safe.Int64ToUint64(v)
+
+	return u, int32(v) // want `unchecked conversion from int64 to int32 may overflow; use safeconversion.Int64ToInt32`
+}
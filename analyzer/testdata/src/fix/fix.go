@@ -0,0 +1,54 @@
+package fix
+
+import "errors"
+
+type header struct {
+	Version int32
+}
+
+var errEmpty = errors.New("empty")
+
+func decode(version int64, bits uint64) (header, int, error) {
+	if version == 0 {
+		return header{}, 0, errEmpty
+	}
+
+	v := int32(version) // want `unchecked conversion from int64 to int32 may overflow; use safeconversion.Int64ToInt32`
+	n := int(bits)      // want `unchecked conversion from uint64 to int may overflow; use safeconversion.Uint64ToInt`
+
+	return header{Version: v}, n, nil
+}
+
+func sum(values []int) uint32 {
+	total := uint32(len(values)) // want `unchecked conversion from int to uint32 may overflow; use safeconversion.IntToUint32`
+	for _, v := range values {
+		total += uint32(v) // want `unchecked conversion from int to uint32 may overflow; use safeconversion.IntToUint32`
+	}
+
+	return total
+}
+
+func callback() func(int64) error {
+	return func(v int64) error {
+		n := uint32(v) // want `unchecked conversion from int64 to uint32 may overflow; use safeconversion.Int64ToUint32`
+		_ = n
+
+		return nil
+	}
+}
+
+type Height int64
+
+func defined(h Height) uint32 {
+	return uint32(h) // want `unchecked conversion from int64 to uint32 may overflow; use safeconversion.Int64ToUint32`
+}
+
+func checksum(data []byte, total uint64) (byte, error) {
+	if len(data) == 0 {
+		return 0, errEmpty
+	}
+
+	sum := byte(total) // want `unchecked conversion from uint64 to byte may overflow; use safeconversion.Uint64ToUint8`
+
+	return sum ^ byte(len(data)), nil // want `unchecked conversion from int to byte may overflow; use safeconversion.IntToByte`
+}
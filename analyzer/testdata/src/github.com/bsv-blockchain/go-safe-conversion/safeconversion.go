@@ -0,0 +1,14 @@
+// Package safeconversion is a stub of the safeconversion package for the analyzer tests.
+package safeconversion
+
+func Int64ToUint64(value int64) (uint64, error) {
+	return uint64(value), nil
+}
+
+func Int64ToInt32(value int64) (int32, error) {
+	return int32(value), nil
+}
+
+func Must[T any](value T, err error) T {
+	return value
+}
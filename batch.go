@@ -0,0 +1,255 @@
+package safeconversion
+
+import (
+	"errors"
+	"math/big"
+	"time"
+)
+
+// Batch accumulates the errors of a sequence of conversions, so that a struct with many
+// fields can be converted with a single error check at the end:
+//
+//	c := NewBatch()
+//	h.Version = c.Field("Version").Int64ToInt32(raw.Version)
+//	h.Bits = c.Field("Bits").Uint64ToUint32(raw.Bits)
+//	if err := c.Err(); err != nil {
+//		return err
+//	}
+//
+// Every conversion that fails returns the zero value of its result type. A Batch created with
+// NewBatch stops converting after the first failure and returns zero values from then on, while
+// one created with NewBatchAll keeps converting and records every failure. A Batch is not safe
+// for concurrent use.
+type Batch struct {
+	label string
+	all   bool
+	errs  []error
+}
+
+// NewBatch returns a Batch that records only the first failed conversion.
+func NewBatch() *Batch {
+	return &Batch{}
+}
+
+// NewBatchAll returns a Batch that records every failed conversion.
+func NewBatchAll() *Batch {
+	return &Batch{all: true}
+}
+
+// Field labels the next conversion with name, so its error is wrapped in a FieldError if it fails.
+func (b *Batch) Field(name string) *Batch {
+	b.label = name
+	return b
+}
+
+// Err returns the recorded errors, joined with errors.Join when there is more than one,
+// or nil if every conversion succeeded.
+func (b *Batch) Err() error {
+	if len(b.errs) == 1 {
+		return b.errs[0]
+	}
+
+	return errors.Join(b.errs...)
+}
+
+// IntToUint32 converts value as IntToUint32 does, recording any error.
+func (b *Batch) IntToUint32(value int) uint32 {
+	return batchConvert(b, IntToUint32, value)
+}
+
+// Uint64ToUint32 converts value as Uint64ToUint32 does, recording any error.
+func (b *Batch) Uint64ToUint32(value uint64) uint32 {
+	return batchConvert(b, Uint64ToUint32, value)
+}
+
+// Int64ToUint64 converts value as Int64ToUint64 does, recording any error.
+func (b *Batch) Int64ToUint64(value int64) uint64 {
+	return batchConvert(b, Int64ToUint64, value)
+}
+
+// IntToUint64 converts value as IntToUint64 does, recording any error.
+func (b *Batch) IntToUint64(value int) uint64 {
+	return batchConvert(b, IntToUint64, value)
+}
+
+// Uint64ToInt converts value as Uint64ToInt does, recording any error.
+func (b *Batch) Uint64ToInt(value uint64) int {
+	return batchConvert(b, Uint64ToInt, value)
+}
+
+// Int64ToInt32 converts value as Int64ToInt32 does, recording any error.
+func (b *Batch) Int64ToInt32(value int64) int32 {
+	return batchConvert(b, Int64ToInt32, value)
+}
+
+// IntToInt32 converts value as IntToInt32 does, recording any error.
+func (b *Batch) IntToInt32(value int) int32 {
+	return batchConvert(b, IntToInt32, value)
+}
+
+// Int32ToUint32 converts value as Int32ToUint32 does, recording any error.
+func (b *Batch) Int32ToUint32(value int32) uint32 {
+	return batchConvert(b, Int32ToUint32, value)
+}
+
+// Int64ToUint32 converts value as Int64ToUint32 does, recording any error.
+func (b *Batch) Int64ToUint32(value int64) uint32 {
+	return batchConvert(b, Int64ToUint32, value)
+}
+
+// BigWordToUint32 converts value as BigWordToUint32 does, recording any error.
+func (b *Batch) BigWordToUint32(value big.Word) uint32 {
+	return batchConvert(b, BigWordToUint32, value)
+}
+
+// IntToUint16 converts value as IntToUint16 does, recording any error.
+func (b *Batch) IntToUint16(value int) uint16 {
+	return batchConvert(b, IntToUint16, value)
+}
+
+// IntToInt16 converts value as IntToInt16 does, recording any error.
+func (b *Batch) IntToInt16(value int) int16 {
+	return batchConvert(b, IntToInt16, value)
+}
+
+// UintToUint32 converts value as UintToUint32 does, recording any error.
+func (b *Batch) UintToUint32(value uint) uint32 {
+	return batchConvert(b, UintToUint32, value)
+}
+
+// TimeToUint32 converts value as TimeToUint32 does, recording any error.
+func (b *Batch) TimeToUint32(value time.Time) uint32 {
+	return batchConvert(b, TimeToUint32, value)
+}
+
+// Uint32ToUint8 converts value as Uint32ToUint8 does, recording any error.
+func (b *Batch) Uint32ToUint8(value uint32) uint8 {
+	return batchConvert(b, Uint32ToUint8, value)
+}
+
+// UintptrToInt converts value as UintptrToInt does, recording any error.
+func (b *Batch) UintptrToInt(value uintptr) int {
+	return batchConvert(b, UintptrToInt, value)
+}
+
+// IntToUintptr converts value as IntToUintptr does, recording any error.
+func (b *Batch) IntToUintptr(value int) uintptr {
+	return batchConvert(b, IntToUintptr, value)
+}
+
+// Int64ToUintptr converts value as Int64ToUintptr does, recording any error.
+func (b *Batch) Int64ToUintptr(value int64) uintptr {
+	return batchConvert(b, Int64ToUintptr, value)
+}
+
+// Uint64ToUintptr converts value as Uint64ToUintptr does, recording any error.
+func (b *Batch) Uint64ToUintptr(value uint64) uintptr {
+	return batchConvert(b, Uint64ToUintptr, value)
+}
+
+// CheckedOffset computes base + index*elemSize as CheckedOffset does, recording any error.
+func (b *Batch) CheckedOffset(base uintptr, index, elemSize uint64) uintptr {
+	label := b.takeLabel()
+	if b.stopped() {
+		return 0
+	}
+
+	address, err := CheckedOffset(base, index, elemSize)
+	if err != nil {
+		b.fail(label, err)
+		return 0
+	}
+
+	return address
+}
+
+// Uint64ToInt64 converts value as Uint64ToInt64 does, recording any error.
+func (b *Batch) Uint64ToInt64(value uint64) int64 {
+	return batchConvert(b, Uint64ToInt64, value)
+}
+
+// Uint32ToInt32 converts value as Uint32ToInt32 does, recording any error.
+func (b *Batch) Uint32ToInt32(value uint32) int32 {
+	return batchConvert(b, Uint32ToInt32, value)
+}
+
+// Uint64ToInt32 converts value as Uint64ToInt32 does, recording any error.
+func (b *Batch) Uint64ToInt32(value uint64) int32 {
+	return batchConvert(b, Uint64ToInt32, value)
+}
+
+// Uint32ToInt64 converts value as Uint32ToInt64 does, recording any error.
+func (b *Batch) Uint32ToInt64(value uint32) int64 {
+	return batchConvert(b, Uint32ToInt64, value)
+}
+
+// Uint32ToUint64 converts value as Uint32ToUint64 does, recording any error.
+func (b *Batch) Uint32ToUint64(value uint32) uint64 {
+	return batchConvert(b, Uint32ToUint64, value)
+}
+
+// Uint64ToUint16 converts value as Uint64ToUint16 does, recording any error.
+func (b *Batch) Uint64ToUint16(value uint64) uint16 {
+	return batchConvert(b, Uint64ToUint16, value)
+}
+
+// IntToByte converts value as IntToByte does, recording any error.
+func (b *Batch) IntToByte(value int) byte {
+	return batchConvert(b, IntToByte, value)
+}
+
+// IntToRune converts value as IntToRune does, recording any error.
+func (b *Batch) IntToRune(value int) rune {
+	return batchConvert(b, IntToRune, value)
+}
+
+// Uint32ToRune converts value as Uint32ToRune does, recording any error.
+func (b *Batch) Uint32ToRune(value uint32) rune {
+	return batchConvert(b, Uint32ToRune, value)
+}
+
+// OpcodeFromInt converts value as OpcodeFromInt does, recording any error.
+func (b *Batch) OpcodeFromInt(value int) byte {
+	return batchConvert(b, OpcodeFromInt, value)
+}
+
+// takeLabel returns and clears the label of the next conversion.
+func (b *Batch) takeLabel() string {
+	label := b.label
+	b.label = ""
+
+	return label
+}
+
+// stopped reports whether conversions should be skipped because an error was already recorded.
+func (b *Batch) stopped() bool {
+	return !b.all && len(b.errs) > 0
+}
+
+// fail records err, wrapped in a FieldError when a label was set.
+func (b *Batch) fail(label string, err error) {
+	if label != "" {
+		err = WithField(label, err)
+	}
+
+	b.errs = append(b.errs, err)
+}
+
+// batchConvert applies fn to value, recording any error in b.
+// Returns the zero value of D if the conversion fails or b has stopped.
+func batchConvert[S, D any](b *Batch, fn func(S) (D, error), value S) D {
+	var zero D
+
+	label := b.takeLabel()
+	if b.stopped() {
+		return zero
+	}
+
+	result, err := fn(value)
+	if err != nil {
+		b.fail(label, err)
+		return zero
+	}
+
+	return result
+}
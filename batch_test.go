@@ -0,0 +1,143 @@
+package safeconversion_test
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"math"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	safe "github.com/bsv-blockchain/go-safe-conversion"
+)
+
+// TestBatchFirstError tests that a Batch stops after the first failed conversion.
+func TestBatchFirstError(t *testing.T) {
+	c := safe.NewBatch()
+
+	version := c.Field("Version").Int64ToInt32(2)
+	bits := c.Field("Bits").Uint64ToUint32(math.MaxUint64)
+	nonce := c.Field("Nonce").Uint64ToUint32(7)
+
+	assert.Equal(t, int32(2), version)
+	assert.Zero(t, bits)
+	assert.Zero(t, nonce, "conversions after a failure return zero values")
+
+	err := c.Err()
+	require.ErrorIs(t, err, safe.ErrValueOverflow)
+	require.ErrorContains(t, err, "Bits: ")
+	assert.NotContains(t, err.Error(), "Nonce")
+
+	var fieldErr *safe.FieldError
+	require.ErrorAs(t, err, &fieldErr)
+	assert.Equal(t, "Bits", fieldErr.Field)
+}
+
+// TestBatchAll tests that a Batch created with NewBatchAll records every failure.
+func TestBatchAll(t *testing.T) {
+	c := safe.NewBatchAll()
+
+	bits := c.Field("Bits").Uint64ToUint32(math.MaxUint64)
+	nonce := c.Field("Nonce").Uint64ToUint32(7)
+	height := c.IntToUint32(-1)
+
+	assert.Zero(t, bits)
+	assert.Equal(t, uint32(7), nonce)
+	assert.Zero(t, height)
+
+	err := c.Err()
+	require.ErrorIs(t, err, safe.ErrValueOverflow)
+	require.ErrorIs(t, err, safe.ErrValueOutOfRange)
+	require.ErrorContains(t, err, "Bits: ")
+	assert.NotContains(t, err.Error(), "Nonce")
+}
+
+// TestBatchNoError tests that a Batch without failures reports no error.
+func TestBatchNoError(t *testing.T) {
+	c := safe.NewBatch()
+	assert.Equal(t, uint16(80), c.Field("Port").IntToUint16(80))
+	assert.Equal(t, uintptr(0x1040), c.CheckedOffset(0x1000, 2, 32))
+	require.NoError(t, c.Err())
+
+	assert.Zero(t, c.CheckedOffset(^uintptr(0), 1, 1))
+	require.ErrorIs(t, c.Err(), safe.ErrValueOverflow)
+}
+
+// TestBatchMethods tests that every Batch method returns the result of the function it wraps.
+func TestBatchMethods(t *testing.T) {
+	c := safe.NewBatch()
+	now := time.Unix(1700000000, 0)
+
+	tests := []struct {
+		name   string
+		result any
+		expect any
+	}{
+		{"IntToUint32", c.IntToUint32(1), uint32(1)},
+		{"Uint64ToUint32", c.Uint64ToUint32(1), uint32(1)},
+		{"Int64ToUint64", c.Int64ToUint64(1), uint64(1)},
+		{"IntToUint64", c.IntToUint64(1), uint64(1)},
+		{"Uint64ToInt", c.Uint64ToInt(1), 1},
+		{"Int64ToInt32", c.Int64ToInt32(1), int32(1)},
+		{"IntToInt32", c.IntToInt32(1), int32(1)},
+		{"Int32ToUint32", c.Int32ToUint32(1), uint32(1)},
+		{"Int64ToUint32", c.Int64ToUint32(1), uint32(1)},
+		{"BigWordToUint32", c.BigWordToUint32(big.Word(1)), uint32(1)},
+		{"IntToUint16", c.IntToUint16(1), uint16(1)},
+		{"IntToInt16", c.IntToInt16(1), int16(1)},
+		{"UintToUint32", c.UintToUint32(1), uint32(1)},
+		{"TimeToUint32", c.TimeToUint32(now), uint32(1700000000)},
+		{"Uint32ToUint8", c.Uint32ToUint8(1), uint8(1)},
+		{"UintptrToInt", c.UintptrToInt(1), 1},
+		{"IntToUintptr", c.IntToUintptr(1), uintptr(1)},
+		{"Int64ToUintptr", c.Int64ToUintptr(1), uintptr(1)},
+		{"Uint64ToUintptr", c.Uint64ToUintptr(1), uintptr(1)},
+		{"CheckedOffset", c.CheckedOffset(1, 1, 1), uintptr(2)},
+		{"Uint64ToInt64", c.Uint64ToInt64(1), int64(1)},
+		{"Uint32ToInt32", c.Uint32ToInt32(1), int32(1)},
+		{"Uint64ToInt32", c.Uint64ToInt32(1), int32(1)},
+		{"Uint32ToInt64", c.Uint32ToInt64(1), int64(1)},
+		{"Uint32ToUint64", c.Uint32ToUint64(1), uint64(1)},
+		{"Uint64ToUint16", c.Uint64ToUint16(1), uint16(1)},
+		{"IntToByte", c.IntToByte(1), byte(1)},
+		{"IntToRune", c.IntToRune('A'), 'A'},
+		{"Uint32ToRune", c.Uint32ToRune('A'), 'A'},
+		{"OpcodeFromInt", c.OpcodeFromInt(1), byte(0x51)},
+	}
+
+	require.NoError(t, c.Err())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expect, tt.result)
+		})
+	}
+}
+
+// TestBatchCoversAllConversions tests that Batch has a method for every conversion in safe_conversion.go.
+func TestBatchCoversAllConversions(t *testing.T) {
+	assertCoversConversions(t, safe.NewBatch())
+}
+
+// assertCoversConversions asserts that v has a method for every exported function in safe_conversion.go.
+func assertCoversConversions(t *testing.T, v any) {
+	t.Helper()
+
+	file, err := parser.ParseFile(token.NewFileSet(), "safe_conversion.go", nil, parser.SkipObjectResolution)
+	require.NoError(t, err)
+
+	typ := reflect.TypeOf(v)
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv != nil || !fn.Name.IsExported() {
+			continue
+		}
+
+		_, found := typ.MethodByName(fn.Name.Name)
+		assert.True(t, found, "%s is missing %s", typ, fn.Name.Name)
+	}
+}
@@ -0,0 +1,248 @@
+package safeconversion
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math/bits"
+	"slices"
+)
+
+// SignedEncoding defines how a signed integer is stored in a variable-length byte encoding.
+type SignedEncoding uint8
+
+const (
+	// TwosComplement stores the value in two's complement, sign-extended from the most significant byte
+	TwosComplement SignedEncoding = iota
+
+	// SignMagnitude stores the magnitude with the sign in the high bit of the most significant byte
+	SignMagnitude
+)
+
+// String returns the name of the encoding.
+func (e SignedEncoding) String() string {
+	switch e {
+	case TwosComplement:
+		return "TwosComplement"
+	case SignMagnitude:
+		return "SignMagnitude"
+	default:
+		return fmt.Sprintf("SignedEncoding(%d)", uint8(e))
+	}
+}
+
+// ReadUint16LE reads a little-endian uint16 from r.
+// Returns an error if the read fails or the value exceeds maxValue.
+func ReadUint16LE(r io.Reader, maxValue uint16) (uint16, error) {
+	var buf [2]byte
+	if _, err := io.ReadFull(r, buf[:]); err != nil {
+		return 0, err
+	}
+
+	value := binary.LittleEndian.Uint16(buf[:])
+	if value > maxValue {
+		return 0, fmt.Errorf("%w (uint16): %d (max %d)", ErrValueExceedsLimit, value, maxValue)
+	}
+
+	return value, nil
+}
+
+// ReadUint32LE reads a little-endian uint32 from r.
+// Returns an error if the read fails or the value exceeds maxValue.
+func ReadUint32LE(r io.Reader, maxValue uint32) (uint32, error) {
+	var buf [4]byte
+	if _, err := io.ReadFull(r, buf[:]); err != nil {
+		return 0, err
+	}
+
+	value := binary.LittleEndian.Uint32(buf[:])
+	if value > maxValue {
+		return 0, fmt.Errorf("%w (uint32): %d (max %d)", ErrValueExceedsLimit, value, maxValue)
+	}
+
+	return value, nil
+}
+
+// ReadUint64LE reads a little-endian uint64 from r.
+// Returns an error if the read fails or the value exceeds maxValue.
+func ReadUint64LE(r io.Reader, maxValue uint64) (uint64, error) {
+	var buf [8]byte
+	if _, err := io.ReadFull(r, buf[:]); err != nil {
+		return 0, err
+	}
+
+	value := binary.LittleEndian.Uint64(buf[:])
+	if value > maxValue {
+		return 0, fmt.Errorf("%w (uint64): %d (max %d)", ErrValueExceedsLimit, value, maxValue)
+	}
+
+	return value, nil
+}
+
+// ReadLenPrefixed reads a little-endian uint64 length followed by that many bytes from r.
+// The buffer is allocated with MakeSliceChecked, so a hostile length prefix cannot trigger
+// an allocation larger than maxLen.
+// Returns an error if the read fails or the length exceeds maxLen.
+func ReadLenPrefixed(r io.Reader, maxLen int) ([]byte, error) {
+	length, err := ReadUint64LE(r, ^uint64(0))
+	if err != nil {
+		return nil, err
+	}
+
+	buf, err := MakeSliceChecked[byte](length, maxLen)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = io.ReadFull(r, buf); err != nil {
+		return nil, err
+	}
+
+	return buf, nil
+}
+
+// PutUint16Checked encodes v into b as a little-endian uint16 using IntToUint16.
+// Returns an error if v is outside the uint16 range or b is shorter than 2 bytes.
+func PutUint16Checked(b []byte, v int) error {
+	value, err := IntToUint16(v)
+	if err != nil {
+		return err
+	}
+
+	if len(b) < 2 {
+		return fmt.Errorf("%w (uint16): buffer length %d", io.ErrShortBuffer, len(b))
+	}
+
+	binary.LittleEndian.PutUint16(b, value)
+
+	return nil
+}
+
+// PutUint32Checked encodes v into b as a little-endian uint32 using IntToUint32.
+// Returns an error if v is outside the uint32 range or b is shorter than 4 bytes.
+func PutUint32Checked(b []byte, v int) error {
+	value, err := IntToUint32(v)
+	if err != nil {
+		return err
+	}
+
+	if len(b) < 4 {
+		return fmt.Errorf("%w (uint32): buffer length %d", io.ErrShortBuffer, len(b))
+	}
+
+	binary.LittleEndian.PutUint32(b, value)
+
+	return nil
+}
+
+// BytesToUint64 decodes a minimal unsigned integer of 1 to 8 bytes stored in the given byte order.
+// Returns an error if b is empty, longer than 8 bytes, or has a leading zero byte other than
+// the single byte encoding zero.
+func BytesToUint64(b []byte, order binary.ByteOrder) (uint64, error) {
+	be, err := variableBytes(b, order, "uint64")
+	if err != nil {
+		return 0, err
+	}
+
+	if len(be) > 1 && be[0] == 0 {
+		return 0, failure("[]byte", "uint64", b, fmt.Errorf("%w (uint64): non-minimal encoding %#x", ErrInvalidSyntax, b))
+	}
+
+	return decodeBigEndian(be), nil
+}
+
+// Uint64ToMinimalBytes encodes value in the given byte order using the fewest bytes, so that
+// BytesToUint64 accepts it. Zero is encoded as a single zero byte.
+func Uint64ToMinimalBytes(value uint64, order binary.ByteOrder) []byte {
+	length := max(1, (bits.Len64(value)+7)/8)
+
+	b := make([]byte, length)
+	for i := range b {
+		b[length-1-i] = byte(value >> (8 * i))
+	}
+
+	if !isBigEndian(order) {
+		slices.Reverse(b)
+	}
+
+	return b
+}
+
+// BytesToInt64Signed decodes a minimal signed integer of 1 to 8 bytes stored in the given byte
+// order and signed encoding.
+// Returns an error if b is empty, longer than 8 bytes, or not minimally encoded: a leading byte
+// that only repeats the sign, or a sign-magnitude negative zero.
+func BytesToInt64Signed(b []byte, order binary.ByteOrder, encoding SignedEncoding) (int64, error) {
+	if encoding != TwosComplement && encoding != SignMagnitude {
+		return 0, fmt.Errorf("%w: %s", ErrUnsupportedType, encoding)
+	}
+
+	be, err := variableBytes(b, order, "int64")
+	if err != nil {
+		return 0, err
+	}
+
+	negative := be[0]&0x80 != 0
+	if encoding == SignMagnitude {
+		be[0] &^= 0x80
+	}
+
+	// The leading byte is redundant when it only repeats the sign held by the next byte.
+	redundant := len(be) > 1 && be[1]&0x80 == 0 && be[0] == 0
+	if encoding == TwosComplement && negative {
+		redundant = len(be) > 1 && be[1]&0x80 != 0 && be[0] == 0xff
+	}
+
+	if redundant || (encoding == SignMagnitude && negative && len(be) == 1 && be[0] == 0) {
+		return 0, failure("[]byte", "int64", b, fmt.Errorf("%w (int64): non-minimal encoding %#x", ErrInvalidSyntax, b))
+	}
+
+	value := decodeBigEndian(be)
+	if encoding == SignMagnitude {
+		if negative {
+			return -int64(value), nil //nolint:gosec // the magnitude of at most 63 bits fits in an int64
+		}
+
+		return int64(value), nil //nolint:gosec // the magnitude of at most 63 bits fits in an int64
+	}
+
+	// Sign-extend the value from its width to 64 bits.
+	shift := 64 - 8*len(be)
+
+	return int64(value<<shift) >> shift, nil //nolint:gosec // the conversion reinterprets the two's complement bits
+}
+
+// variableBytes returns a big-endian copy of an encoding of 1 to 8 bytes stored in the given
+// byte order, reporting a failure as a conversion to the dst type.
+func variableBytes(b []byte, order binary.ByteOrder, dst string) ([]byte, error) {
+	if len(b) == 0 {
+		return nil, failure("[]byte", dst, b, fmt.Errorf("%w (%s): empty encoding", ErrInvalidSyntax, dst))
+	}
+
+	if len(b) > 8 {
+		return nil, failure("[]byte", dst, b, fmt.Errorf("%w (%s): %d bytes (max 8)", ErrValueOutOfRange, dst, len(b)))
+	}
+
+	be := append([]byte(nil), b...)
+	if !isBigEndian(order) {
+		slices.Reverse(be)
+	}
+
+	return be, nil
+}
+
+// decodeBigEndian returns the unsigned value of up to 8 big-endian bytes.
+func decodeBigEndian(b []byte) uint64 {
+	var value uint64
+	for _, c := range b {
+		value = value<<8 | uint64(c)
+	}
+
+	return value
+}
+
+// isBigEndian reports whether order stores the most significant byte first. binary.ByteOrder
+// does not expose its order, so it is detected by decoding a known value.
+func isBigEndian(order binary.ByteOrder) bool {
+	return order.Uint16([]byte{0x00, 0x01}) == 1
+}
@@ -0,0 +1,267 @@
+package safeconversion_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	safe "github.com/bsv-blockchain/go-safe-conversion"
+)
+
+// TestReadUint32LE tests reading bounded little-endian uint32 values.
+func TestReadUint32LE(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []byte
+		maxValue uint32
+		expect   uint32
+		wantErr  error
+	}{
+		{zeroValueName, []byte{0, 0, 0, 0}, math.MaxUint32, 0, nil},
+		{positiveValueName, []byte{100, 0, 0, 0}, math.MaxUint32, 100, nil},
+		{maxUint32Name, []byte{0xff, 0xff, 0xff, 0xff}, math.MaxUint32, math.MaxUint32, nil},
+		{"at limit", []byte{0x00, 0x01, 0, 0}, 256, 256, nil},
+		{"above limit", []byte{0x01, 0x01, 0, 0}, 256, 0, safe.ErrValueExceedsLimit},
+		{"short read", []byte{0x01, 0x01}, 256, 0, io.ErrUnexpectedEOF},
+		{"empty read", nil, 256, 0, io.EOF},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := safe.ReadUint32LE(bytes.NewReader(tt.input), tt.maxValue)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expect, result)
+		})
+	}
+}
+
+// TestReadUint16LE tests reading bounded little-endian uint16 values.
+func TestReadUint16LE(t *testing.T) {
+	result, err := safe.ReadUint16LE(bytes.NewReader([]byte{0xff, 0xff}), math.MaxUint16)
+	require.NoError(t, err)
+	assert.Equal(t, uint16(math.MaxUint16), result)
+
+	_, err = safe.ReadUint16LE(bytes.NewReader([]byte{0x01, 0x01}), 0xff)
+	require.ErrorIs(t, err, safe.ErrValueExceedsLimit)
+
+	_, err = safe.ReadUint16LE(bytes.NewReader([]byte{0x01}), 0xff)
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
+
+// TestReadUint64LE tests reading bounded little-endian uint64 values.
+func TestReadUint64LE(t *testing.T) {
+	input := binary.LittleEndian.AppendUint64(nil, math.MaxUint64)
+	result, err := safe.ReadUint64LE(bytes.NewReader(input), math.MaxUint64)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(math.MaxUint64), result)
+
+	_, err = safe.ReadUint64LE(bytes.NewReader(input), math.MaxInt64)
+	require.ErrorIs(t, err, safe.ErrValueExceedsLimit)
+}
+
+// TestReadLenPrefixed tests reading length-prefixed byte strings.
+func TestReadLenPrefixed(t *testing.T) {
+	prefixed := func(length uint64, payload []byte) []byte {
+		return append(binary.LittleEndian.AppendUint64(nil, length), payload...)
+	}
+
+	tests := []struct {
+		name    string
+		input   []byte
+		maxLen  int
+		expect  []byte
+		wantErr error
+	}{
+		{"empty payload", prefixed(0, nil), 10, []byte{}, nil},
+		{"small payload", prefixed(3, []byte("abc")), 10, []byte("abc"), nil},
+		{"at limit", prefixed(3, []byte("abc")), 3, []byte("abc"), nil},
+		{"above limit", prefixed(4, []byte("abcd")), 3, nil, safe.ErrValueExceedsLimit},
+		{"length exceeds int", prefixed(math.MaxUint64, nil), math.MaxInt, nil, safe.ErrValueExceedsLimit},
+		{"huge length", prefixed(math.MaxInt64, nil), 1024, nil, safe.ErrValueExceedsLimit},
+		{"truncated payload", prefixed(5, []byte("abc")), 10, nil, io.ErrUnexpectedEOF},
+		{"truncated prefix", []byte{1, 2, 3}, 10, nil, io.ErrUnexpectedEOF},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := safe.ReadLenPrefixed(bytes.NewReader(tt.input), tt.maxLen)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expect, result)
+		})
+	}
+}
+
+// TestPutUint16Checked tests encoding checked little-endian uint16 values.
+func TestPutUint16Checked(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   int
+		bufLen  int
+		expect  []byte
+		wantErr error
+	}{
+		{zeroValueName, 0, 2, []byte{0, 0}, nil},
+		{maxUint16Name, math.MaxUint16, 2, []byte{0xff, 0xff}, nil},
+		{"larger buffer", 0x0102, 3, []byte{0x02, 0x01, 0}, nil},
+		{negativeValueName, -1, 2, nil, safe.ErrNegativeValueCannotBeConverted},
+		{valueTooLargeName, math.MaxUint16 + 1, 2, nil, safe.ErrValueExceedsLimit},
+		{"short buffer", 1, 1, nil, io.ErrShortBuffer},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := make([]byte, tt.bufLen)
+			err := safe.PutUint16Checked(buf, tt.input)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expect, buf)
+		})
+	}
+}
+
+// TestPutUint32Checked tests encoding checked little-endian uint32 values.
+func TestPutUint32Checked(t *testing.T) {
+	buf := make([]byte, 4)
+	require.NoError(t, safe.PutUint32Checked(buf, math.MaxUint32))
+	assert.Equal(t, []byte{0xff, 0xff, 0xff, 0xff}, buf)
+
+	require.ErrorIs(t, safe.PutUint32Checked(buf, -1), safe.ErrValueOutOfRange)
+	require.ErrorIs(t, safe.PutUint32Checked(buf, math.MaxUint32+1), safe.ErrValueOutOfRange)
+	require.ErrorIs(t, safe.PutUint32Checked(buf[:3], 1), io.ErrShortBuffer)
+}
+
+// FuzzReadLenPrefixed validates that ReadLenPrefixed never returns more than maxLen bytes.
+func FuzzReadLenPrefixed(f *testing.F) {
+	f.Add([]byte{3, 0, 0, 0, 0, 0, 0, 0, 'a', 'b', 'c'}, 3)
+	f.Add([]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, 1024)
+	f.Add([]byte{}, 0)
+	f.Fuzz(func(t *testing.T, input []byte, maxLen int) {
+		maxLen = min(maxLen, 1<<20) // keep allocations bounded while fuzzing
+		result, err := safe.ReadLenPrefixed(bytes.NewReader(input), maxLen)
+		if err != nil {
+			return
+		}
+		assert.LessOrEqual(t, len(result), maxLen)
+		assert.Equal(t, input[8:8+len(result)], result)
+	})
+}
+
+// TestBytesToUint64 tests decoding minimal variable-length unsigned integers.
+func TestBytesToUint64(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   []byte
+		order   binary.ByteOrder
+		expect  uint64
+		wantErr error
+	}{
+		{zeroValueName, []byte{0}, binary.BigEndian, 0, nil},
+		{"big-endian", []byte{0x01, 0x02, 0x03}, binary.BigEndian, 0x010203, nil},
+		{"little-endian", []byte{0x03, 0x02, 0x01}, binary.LittleEndian, 0x010203, nil},
+		{maxUint64Name, bytes.Repeat([]byte{0xff}, 8), binary.LittleEndian, math.MaxUint64, nil},
+		{"leading zero big-endian", []byte{0x00, 0x80}, binary.BigEndian, 0, safe.ErrInvalidSyntax},
+		{"leading zero little-endian", []byte{0x80, 0x00}, binary.LittleEndian, 0, safe.ErrInvalidSyntax},
+		{"empty", nil, binary.BigEndian, 0, safe.ErrInvalidSyntax},
+		{"too long", []byte{1, 0, 0, 0, 0, 0, 0, 0, 0}, binary.BigEndian, 0, safe.ErrValueOutOfRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := safe.BytesToUint64(tt.input, tt.order)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expect, result)
+		})
+	}
+}
+
+// TestUint64ToMinimalBytes tests encoding unsigned integers with the fewest bytes.
+func TestUint64ToMinimalBytes(t *testing.T) {
+	assert.Equal(t, []byte{0}, safe.Uint64ToMinimalBytes(0, binary.BigEndian))
+	assert.Equal(t, []byte{0xff}, safe.Uint64ToMinimalBytes(0xff, binary.LittleEndian))
+	assert.Equal(t, []byte{0x01, 0x00}, safe.Uint64ToMinimalBytes(0x100, binary.BigEndian))
+	assert.Equal(t, []byte{0x00, 0x01}, safe.Uint64ToMinimalBytes(0x100, binary.LittleEndian))
+	assert.Len(t, safe.Uint64ToMinimalBytes(math.MaxUint64, binary.BigEndian), 8)
+}
+
+// TestBytesToInt64Signed tests decoding minimal variable-length signed integers.
+func TestBytesToInt64Signed(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []byte
+		order    binary.ByteOrder
+		encoding safe.SignedEncoding
+		expect   int64
+		wantErr  error
+	}{
+		{zeroValueName, []byte{0}, binary.BigEndian, safe.TwosComplement, 0, nil},
+		{"two's complement -1", []byte{0xff}, binary.BigEndian, safe.TwosComplement, -1, nil},
+		{"two's complement 128", []byte{0x00, 0x80}, binary.BigEndian, safe.TwosComplement, 128, nil},
+		{"two's complement -129", []byte{0x7f, 0xff}, binary.LittleEndian, safe.TwosComplement, -129, nil},
+		{"two's complement min", []byte{0x80, 0, 0, 0, 0, 0, 0, 0}, binary.BigEndian, safe.TwosComplement, math.MinInt64, nil},
+		{"two's complement redundant zero", []byte{0x00, 0x7f}, binary.BigEndian, safe.TwosComplement, 0, safe.ErrInvalidSyntax},
+		{"two's complement redundant sign", []byte{0xff, 0x80}, binary.BigEndian, safe.TwosComplement, 0, safe.ErrInvalidSyntax},
+		{"sign-magnitude -1", []byte{0x81}, binary.BigEndian, safe.SignMagnitude, -1, nil},
+		{"sign-magnitude -128", []byte{0x80, 0x80}, binary.LittleEndian, safe.SignMagnitude, -128, nil},
+		{"sign-magnitude max", []byte{0x7f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, binary.BigEndian, safe.SignMagnitude, math.MaxInt64, nil},
+		{"sign-magnitude min", bytes.Repeat([]byte{0xff}, 8), binary.BigEndian, safe.SignMagnitude, -math.MaxInt64, nil},
+		{"sign-magnitude negative zero", []byte{0x80}, binary.BigEndian, safe.SignMagnitude, 0, safe.ErrInvalidSyntax},
+		{"sign-magnitude redundant byte", []byte{0x80, 0x7f}, binary.BigEndian, safe.SignMagnitude, 0, safe.ErrInvalidSyntax},
+		{"empty", []byte{}, binary.BigEndian, safe.TwosComplement, 0, safe.ErrInvalidSyntax},
+		{"too long", make([]byte, 9), binary.BigEndian, safe.SignMagnitude, 0, safe.ErrValueOutOfRange},
+		{"unknown encoding", []byte{1}, binary.BigEndian, safe.SignedEncoding(9), 0, safe.ErrUnsupportedType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := safe.BytesToInt64Signed(tt.input, tt.order, tt.encoding)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expect, result)
+		})
+	}
+}
+
+// FuzzUint64ToMinimalBytes validates that minimal encodings decode back to the same value.
+func FuzzUint64ToMinimalBytes(f *testing.F) {
+	f.Add(uint64(0), false)
+	f.Add(uint64(0x100), true)
+	f.Add(uint64(math.MaxUint64), false)
+	f.Fuzz(func(t *testing.T, v uint64, littleEndian bool) {
+		var order binary.ByteOrder = binary.BigEndian
+		if littleEndian {
+			order = binary.LittleEndian
+		}
+
+		result, err := safe.BytesToUint64(safe.Uint64ToMinimalBytes(v, order), order)
+		require.NoError(t, err)
+		assert.Equal(t, v, result)
+	})
+}
@@ -0,0 +1,131 @@
+package safeconversion
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Range defines an inclusive range of values that a Bounded value must fall within.
+type Range[T Integer] struct {
+	Min T
+	Max T
+}
+
+// Check returns an error if the value falls outside the range.
+func (r Range[T]) Check(value T) error {
+	if value < r.Min || value > r.Max {
+		return fmt.Errorf("%w (%s [%d, %d]): %d", ErrValueOutOfRange, typeName[T](), r.Min, r.Max, value)
+	}
+
+	return nil
+}
+
+// Bounded holds an integer that is range-checked whenever it is parsed from text.
+// It implements encoding.TextMarshaler, encoding.TextUnmarshaler, flag.Getter and fmt.Stringer,
+// so it can be used directly with the flag package and with configuration libraries that honor
+// those interfaces. Values are always checked against the range of T and, when Range is set,
+// against the custom range as well.
+type Bounded[T Integer] struct {
+	Value T
+	Range *Range[T]
+}
+
+// Fixed-width Bounded types for use with flag.Var and configuration structs.
+type (
+	BoundedInt8   = Bounded[int8]
+	BoundedInt16  = Bounded[int16]
+	BoundedInt32  = Bounded[int32]
+	BoundedInt64  = Bounded[int64]
+	BoundedUint8  = Bounded[uint8]
+	BoundedUint16 = Bounded[uint16]
+	BoundedUint32 = Bounded[uint32]
+	BoundedUint64 = Bounded[uint64]
+)
+
+// NewBounded returns a Bounded holding the default value and constrained to r, which may be nil.
+func NewBounded[T Integer](value T, r *Range[T]) *Bounded[T] {
+	return &Bounded[T]{Value: value, Range: r}
+}
+
+// Set parses s and stores the result, implementing flag.Value.
+// The stored value is left unchanged if s is not a valid number or is out of range.
+func (b *Bounded[T]) Set(s string) error {
+	value, err := parseInteger[T](s, 0)
+	if err != nil {
+		return failure("string", typeName[T](), s, err)
+	}
+
+	if b.Range != nil {
+		if err = b.Range.Check(value); err != nil {
+			// The error reports the custom range as its bounds, rather than the range of T.
+			convErr := newConversionError("string", typeName[T](), s, err)
+			convErr.Min, convErr.Max = b.Range.Min, b.Range.Max
+
+			return notify(convErr)
+		}
+	}
+
+	b.Value = value
+
+	return nil
+}
+
+// Get returns the stored value, implementing flag.Getter.
+func (b *Bounded[T]) Get() any {
+	return b.Value
+}
+
+// String returns the stored value in base 10, implementing fmt.Stringer and flag.Value.
+func (b *Bounded[T]) String() string {
+	if b == nil {
+		return "0"
+	}
+
+	return fmt.Sprint(b.Value)
+}
+
+// UnmarshalText parses text and stores the result, implementing encoding.TextUnmarshaler.
+func (b *Bounded[T]) UnmarshalText(text []byte) error {
+	return b.Set(string(text))
+}
+
+// MarshalText returns the stored value in base 10, implementing encoding.TextMarshaler.
+func (b Bounded[T]) MarshalText() ([]byte, error) {
+	return []byte(fmt.Sprint(b.Value)), nil
+}
+
+// parseInteger parses s in the given base as an integer of type T.
+// Accepts the same syntax as strconv.ParseInt, so base 0 allows base prefixes and underscores.
+func parseInteger[T Integer](s string, base int) (T, error) {
+	if isSigned[T]() || strings.HasPrefix(s, "-") {
+		value, err := strconv.ParseInt(s, base, 64)
+		if err != nil {
+			return 0, parseError[T](s, err)
+		}
+
+		return fromInt64[T](value)
+	}
+
+	// strconv.ParseUint rejects the sign that strconv.ParseInt accepts, so a plus sign is dropped first.
+	value, err := strconv.ParseUint(strings.TrimPrefix(s, "+"), base, 64)
+	if err != nil {
+		return 0, parseError[T](s, err)
+	}
+
+	return fromUint64[T](value)
+}
+
+// parseError maps a strconv error onto the package's sentinel errors.
+func parseError[T Integer](s string, err error) error {
+	if !errors.Is(err, strconv.ErrRange) {
+		return fmt.Errorf("%w (%s): %q", ErrInvalidSyntax, typeName[T](), s)
+	}
+
+	if !isSigned[T]() && strings.HasPrefix(s, "-") {
+		return fmt.Errorf("%w to %s: %s", ErrNegativeValueCannotBeConverted, typeName[T](), s)
+	}
+
+	return fmt.Errorf("%w (%s): %s", ErrValueOutOfRange, typeName[T](), s)
+}
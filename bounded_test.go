@@ -0,0 +1,165 @@
+package safeconversion_test
+
+import (
+	"encoding"
+	"flag"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	safe "github.com/bsv-blockchain/go-safe-conversion"
+)
+
+var (
+	_ flag.Getter              = (*safe.BoundedUint16)(nil)
+	_ encoding.TextUnmarshaler = (*safe.BoundedUint16)(nil)
+	_ encoding.TextMarshaler   = safe.BoundedUint16{}
+	_ fmt.Stringer             = (*safe.BoundedUint16)(nil)
+)
+
+// TestBoundedUint16Set tests parsing text into a Bounded uint16.
+func TestBoundedUint16Set(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		r       *safe.Range[uint16]
+		expect  uint16
+		wantErr error
+	}{
+		{zeroValueName, "0", nil, 0, nil},
+		{positiveValueName, "100", nil, 100, nil},
+		{"hex value", "0x10", nil, 16, nil},
+		{"underscore value", "1_000", nil, 1000, nil},
+		{"plus sign", "+8333", nil, 8333, nil},
+		{maxUint16Name, "65535", nil, 65535, nil},
+		{valueTooLargeName, "65536", nil, 0, safe.ErrValueOutOfRange},
+		{valueMuchTooLargeName, "99999999999999999999", nil, 0, safe.ErrValueOutOfRange},
+		{negativeValueName, "-1", nil, 0, safe.ErrNegativeValueCannotBeConverted},
+		{"very negative value", "-99999999999999999999", nil, 0, safe.ErrNegativeValueCannotBeConverted},
+		{"invalid syntax", "abc", nil, 0, safe.ErrInvalidSyntax},
+		{"empty string", "", nil, 0, safe.ErrInvalidSyntax},
+		{"within custom range", "8333", &safe.Range[uint16]{Min: 1, Max: 10000}, 8333, nil},
+		{"below custom range", "0", &safe.Range[uint16]{Min: 1, Max: 10000}, 0, safe.ErrValueOutOfRange},
+		{"above custom range", "10001", &safe.Range[uint16]{Min: 1, Max: 10000}, 0, safe.ErrValueOutOfRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := safe.NewBounded(uint16(7), tt.r)
+			err := b.Set(tt.input)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Equal(t, uint16(7), b.Value)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expect, b.Value)
+		})
+	}
+}
+
+// TestBoundedInt8Set tests parsing text into a Bounded int8.
+func TestBoundedInt8Set(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		r       *safe.Range[int8]
+		expect  int8
+		wantErr error
+	}{
+		{zeroValueName, "0", nil, 0, nil},
+		{"min int8", "-128", nil, -128, nil},
+		{"max int8", "127", nil, 127, nil},
+		{valueTooSmallName, "-129", nil, 0, safe.ErrValueOutOfRange},
+		{valueTooLargeName, "128", nil, 0, safe.ErrValueOutOfRange},
+		{valueMuchTooLargeName, "99999999999999999999", nil, 0, safe.ErrValueOutOfRange},
+		{"invalid syntax", "1.5", nil, 0, safe.ErrInvalidSyntax},
+		{"within custom range", "-5", &safe.Range[int8]{Min: -10, Max: 10}, -5, nil},
+		{"below custom range", "-11", &safe.Range[int8]{Min: -10, Max: 10}, 0, safe.ErrValueOutOfRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b safe.BoundedInt8
+			b.Range = tt.r
+			err := b.Set(tt.input)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expect, b.Value)
+		})
+	}
+}
+
+// TestBoundedUint64Set tests parsing the full uint64 range into a Bounded uint64.
+func TestBoundedUint64Set(t *testing.T) {
+	var b safe.BoundedUint64
+	require.NoError(t, b.Set("18446744073709551615"))
+	assert.Equal(t, uint64(18446744073709551615), b.Value)
+
+	err := b.Set("18446744073709551616")
+	require.ErrorIs(t, err, safe.ErrValueOutOfRange)
+}
+
+// TestBoundedFlag tests using a Bounded value with a flag.FlagSet.
+func TestBoundedFlag(t *testing.T) {
+	newFlagSet := func() (*flag.FlagSet, *safe.BoundedUint32) {
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		fs.SetOutput(io.Discard)
+		maxConns := safe.NewBounded[uint32](125, &safe.Range[uint32]{Min: 1, Max: 1000})
+		fs.Var(maxConns, "max-connections", "maximum number of connections")
+		return fs, maxConns
+	}
+
+	t.Run("default value", func(t *testing.T) {
+		fs, maxConns := newFlagSet()
+		require.NoError(t, fs.Parse(nil))
+		assert.Equal(t, uint32(125), maxConns.Value)
+		assert.Equal(t, "125", fs.Lookup("max-connections").DefValue)
+	})
+
+	t.Run("valid value", func(t *testing.T) {
+		fs, maxConns := newFlagSet()
+		require.NoError(t, fs.Parse([]string{"--max-connections", "500"}))
+		assert.Equal(t, uint32(500), maxConns.Value)
+		assert.Equal(t, uint32(500), maxConns.Get())
+	})
+
+	t.Run("out of range value", func(t *testing.T) {
+		fs, _ := newFlagSet()
+		err := fs.Parse([]string{"--max-connections", "5000"})
+		require.ErrorContains(t, err, safe.ErrValueOutOfRange.Error())
+	})
+}
+
+// TestBoundedText tests the text marshaling round trip.
+func TestBoundedText(t *testing.T) {
+	b := safe.BoundedInt64{Value: -42}
+	text, err := b.MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "-42", string(text))
+
+	var decoded safe.BoundedInt64
+	require.NoError(t, decoded.UnmarshalText(text))
+	assert.Equal(t, b.Value, decoded.Value)
+	assert.Equal(t, "-42", decoded.String())
+
+	var nilBounded *safe.BoundedInt64
+	assert.Equal(t, "0", nilBounded.String())
+}
+
+// TestRangeCheck tests checking values against a custom range.
+func TestRangeCheck(t *testing.T) {
+	r := safe.Range[int32]{Min: -1, Max: 1}
+	require.NoError(t, r.Check(-1))
+	require.NoError(t, r.Check(1))
+	require.ErrorIs(t, r.Check(2), safe.ErrValueOutOfRange)
+	require.ErrorIs(t, r.Check(-2), safe.ErrValueOutOfRange)
+}
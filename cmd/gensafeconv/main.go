@@ -0,0 +1,373 @@
+// Package main provides gensafeconv, the generator for the conversion matrix of the
+// safeconversion package. It is run with go generate from the package directory:
+//
+//	//go:generate go run ./cmd/gensafeconv
+//
+// For every ordered pair of integer types in the table below that is not already converted
+// by a hand-written function, it emits the conversion function, its Batch and Policy methods,
+// and its test, fuzz, benchmark and example functions. Adding an integer type means adding
+// one row to the table.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+// integerType describes one row of the conversion matrix.
+type integerType struct {
+	// Name is the exported name used in function names, such as "Uint16".
+	Name string
+
+	// Type is the Go type, such as "uint16".
+	Type string
+
+	// Signed is set for signed types.
+	Signed bool
+
+	// Bits is the width of the type, or 0 for int, uint and uintptr, whose width is
+	// 32 or 64 bits depending on the platform.
+	Bits uint
+
+	// Min and Max are constant expressions for the bounds of the type.
+	Min string
+	Max string
+
+	// FuzzType is the type used for fuzz arguments, for types the fuzzer does not support.
+	FuzzType string
+}
+
+// types is the table the conversion matrix is generated from.
+func types() []integerType {
+	return []integerType{
+		{Name: "Int", Type: "int", Signed: true, Min: "math.MinInt", Max: "math.MaxInt"},
+		{Name: "Int8", Type: "int8", Signed: true, Bits: 8, Min: "math.MinInt8", Max: "math.MaxInt8"},
+		{Name: "Int16", Type: "int16", Signed: true, Bits: 16, Min: "math.MinInt16", Max: "math.MaxInt16"},
+		{Name: "Int32", Type: "int32", Signed: true, Bits: 32, Min: "math.MinInt32", Max: "math.MaxInt32"},
+		{Name: "Int64", Type: "int64", Signed: true, Bits: 64, Min: "math.MinInt64", Max: "math.MaxInt64"},
+		{Name: "Uint", Type: "uint", Min: "0", Max: "math.MaxUint"},
+		{Name: "Uint8", Type: "uint8", Bits: 8, Min: "0", Max: "math.MaxUint8"},
+		{Name: "Uint16", Type: "uint16", Bits: 16, Min: "0", Max: "math.MaxUint16"},
+		{Name: "Uint32", Type: "uint32", Bits: 32, Min: "0", Max: "math.MaxUint32"},
+		{Name: "Uint64", Type: "uint64", Bits: 64, Min: "0", Max: "math.MaxUint64"},
+		{Name: "Uintptr", Type: "uintptr", Min: "0", Max: "^uintptr(0)", FuzzType: "uint64"},
+	}
+}
+
+// conversion is one generated function of the matrix.
+type conversion struct {
+	Func string
+	From integerType
+	To   integerType
+
+	// Safe is set when every value of From is representable in To on every platform.
+	Safe bool
+
+	// Doc is the second line of the doc comment, describing when the conversion fails.
+	Doc string
+
+	// Values are constant expressions of type From used as test inputs and fuzz seeds.
+	Values []string
+}
+
+// output is a generated file and the template that produces it.
+type output struct {
+	path     string
+	template string
+}
+
+func main() {
+	dir := flag.String("dir", ".", "directory of the safeconversion package")
+	flag.Parse()
+
+	files, err := generate(*dir)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for path, content := range files {
+		if err = os.WriteFile(path, content, 0o600); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// generate returns the content of every generated file, keyed by path.
+func generate(dir string) (map[string][]byte, error) {
+	existing, err := handWritten(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	conversions := matrix(existing)
+	outputs := []output{
+		{filepath.Join(dir, "conversions_gen.go"), conversionsTemplate},
+		{filepath.Join(dir, "conversions_gen_test.go"), testsTemplate},
+		{filepath.Join(dir, "conversions_gen_examples_test.go"), examplesTemplate},
+		{filepath.Join(dir, "analyzer", "functions_gen.go"), analyzerTemplate},
+	}
+
+	files := make(map[string][]byte, len(outputs))
+	for _, out := range outputs {
+		content, renderErr := render(out.template, conversions)
+		if renderErr != nil {
+			return nil, fmt.Errorf("%s: %w", out.path, renderErr)
+		}
+
+		files[out.path] = content
+	}
+
+	return files, nil
+}
+
+// handWritten returns the names of the exported functions declared in the hand-written,
+// non-test files of the package in dir.
+func handWritten(dir string) (map[string]bool, error) {
+	matches, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[string]bool)
+	fset := token.NewFileSet()
+
+	for _, path := range matches {
+		if strings.HasSuffix(path, "_test.go") || strings.HasSuffix(path, "_gen.go") {
+			continue
+		}
+
+		file, parseErr := parser.ParseFile(fset, path, nil, parser.SkipObjectResolution)
+		if parseErr != nil {
+			return nil, parseErr
+		}
+
+		for _, decl := range file.Decls {
+			if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv == nil && fn.Name.IsExported() {
+				names[fn.Name.Name] = true
+			}
+		}
+	}
+
+	return names, nil
+}
+
+// matrix returns the conversions between every ordered pair of types that is not hand-written.
+func matrix(existing map[string]bool) []conversion {
+	var conversions []conversion
+
+	for _, from := range types() {
+		for _, to := range types() {
+			name := from.Name + "To" + to.Name
+			if from.Type == to.Type || existing[name] {
+				continue
+			}
+
+			conversions = append(conversions, conversion{
+				Func:   name,
+				From:   from,
+				To:     to,
+				Safe:   fits(from, to),
+				Doc:    doc(from, to),
+				Values: values(from, to),
+			})
+		}
+	}
+
+	return conversions
+}
+
+// widths returns the possible widths of t in bits.
+func widths(t integerType) []uint {
+	if t.Bits == 0 {
+		return []uint{32, 64}
+	}
+
+	return []uint{t.Bits}
+}
+
+// bounds returns the bounds of t when it is bits wide.
+func bounds(t integerType, bits uint) (*big.Int, *big.Int) {
+	one := big.NewInt(1)
+	if !t.Signed {
+		return new(big.Int), new(big.Int).Sub(new(big.Int).Lsh(one, bits), one)
+	}
+
+	limit := new(big.Int).Lsh(one, bits-1)
+
+	return new(big.Int).Neg(limit), new(big.Int).Sub(limit, one)
+}
+
+// fits reports whether every value of from is representable in to on every platform.
+func fits(from, to integerType) bool {
+	for _, fromBits := range widths(from) {
+		fromMin, fromMax := bounds(from, fromBits)
+
+		for _, toBits := range widths(to) {
+			toMin, toMax := bounds(to, toBits)
+			if fromMin.Cmp(toMin) < 0 || fromMax.Cmp(toMax) > 0 {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// doc returns the sentence describing when the conversion from one type to another fails.
+func doc(from, to integerType) string {
+	if fits(from, to) {
+		return fmt.Sprintf("Since all %s values are within the valid %s range, the conversion is always safe.", from.Type, to.Type)
+	}
+
+	platform := ""
+	if to.Bits == 0 {
+		platform = ", which is 32 bits on 32-bit platforms"
+	}
+
+	negative := from.Signed && !to.Signed
+	nonNegative := from
+	nonNegative.Signed = false
+
+	switch {
+	case negative && fits(nonNegative, to):
+		return "Returns an error if the value is negative."
+	case negative:
+		return fmt.Sprintf("Returns an error if the value is negative or exceeds the maximum value of an %s%s.", to.Type, platform)
+	case from.Signed:
+		return fmt.Sprintf("Returns an error if the value is outside the range of an %s%s.", to.Type, platform)
+	default:
+		return fmt.Sprintf("Returns an error if the value exceeds the maximum value of an %s%s.", to.Type, platform)
+	}
+}
+
+// values returns the test inputs for the conversion: the bounds of from, the values around
+// zero, and the bounds of to and their neighbors when they are representable in from on
+// every platform.
+func values(from, to integerType) []string {
+	candidates := []string{from.Min, "0", "1", from.Max}
+	if from.Signed {
+		candidates = []string{from.Min, from.Min + " + 1", "-1", "0", "1", from.Max + " - 1", from.Max}
+	}
+
+	if to.Type != "uintptr" {
+		candidates = append(candidates, to.Min+" - 1", to.Min, to.Max, to.Max+" + 1")
+	}
+
+	var result []string
+	seen := make(map[string]bool)
+
+	for _, expr := range candidates {
+		value, ok := representable(expr, from, to)
+		if !ok || seen[value] {
+			continue
+		}
+
+		seen[value] = true
+		result = append(result, expr)
+	}
+
+	return result
+}
+
+// representable evaluates expr on every platform and reports whether it is representable
+// in from on all of them. It returns the value on the widest platform as a deduplication key.
+func representable(expr string, from, to integerType) (string, bool) {
+	var key string
+
+	for _, bits := range []uint{32, 64} {
+		value, ok := evaluate(expr, from, to, bits)
+		if !ok {
+			return "", false
+		}
+
+		fromMin, fromMax := bounds(from, widthOn(from, bits))
+		if value.Cmp(fromMin) < 0 || value.Cmp(fromMax) > 0 {
+			return "", false
+		}
+
+		key = value.String()
+	}
+
+	return key, true
+}
+
+// widthOn returns the width of t on a platform with the given word size.
+func widthOn(t integerType, bits uint) uint {
+	if t.Bits == 0 {
+		return bits
+	}
+
+	return t.Bits
+}
+
+// evaluate computes a bound expression of the form "<bound>", "<bound> + 1" or "<bound> - 1"
+// on a platform with the given word size.
+func evaluate(expr string, from, to integerType, bits uint) (*big.Int, bool) {
+	base, offset := expr, int64(0)
+	if before, ok := strings.CutSuffix(expr, " + 1"); ok {
+		base, offset = before, 1
+	} else if before, ok = strings.CutSuffix(expr, " - 1"); ok {
+		base, offset = before, -1
+	}
+
+	var value *big.Int
+
+	for _, t := range []integerType{from, to} {
+		minValue, maxValue := bounds(t, widthOn(t, bits))
+		switch base {
+		case t.Min:
+			value = minValue
+		case t.Max:
+			value = maxValue
+		}
+	}
+
+	switch base {
+	case "0":
+		value = big.NewInt(0)
+	case "1":
+		value = big.NewInt(1)
+	case "-1":
+		value = big.NewInt(-1)
+	}
+
+	if value == nil {
+		return nil, false
+	}
+
+	return new(big.Int).Add(value, big.NewInt(offset)), true
+}
+
+// render executes a template over the conversions and formats the result.
+func render(text string, conversions []conversion) ([]byte, error) {
+	tmpl, err := template.New("").Funcs(template.FuncMap{
+		"fuzzType": func(t integerType) string {
+			if t.FuzzType != "" {
+				return t.FuzzType
+			}
+
+			return t.Type
+		},
+	}).Parse(text)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err = tmpl.Execute(&buf, conversions); err != nil {
+		return nil, err
+	}
+
+	return format.Source(buf.Bytes())
+}
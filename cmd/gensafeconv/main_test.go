@@ -0,0 +1,50 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGeneratedFilesUpToDate tests that the committed generated files match the generator output.
+func TestGeneratedFilesUpToDate(t *testing.T) {
+	files, err := generate(filepath.Join("..", ".."))
+	require.NoError(t, err)
+
+	for path, content := range files {
+		current, readErr := os.ReadFile(path) //nolint:gosec // paths are produced by the generator
+		require.NoError(t, readErr)
+		assert.Equal(t, string(content), string(current), "%s is out of date; run go generate", path)
+	}
+}
+
+// TestFits tests the range comparison used to find always-safe conversions.
+func TestFits(t *testing.T) {
+	byName := make(map[string]integerType)
+	for _, row := range types() {
+		byName[row.Type] = row
+	}
+
+	tests := []struct {
+		from, to string
+		expect   bool
+	}{
+		{"int8", "int16", true},
+		{"int16", "int8", false},
+		{"uint8", "int16", true},
+		{"int8", "uint64", false},
+		{"int32", "int", true},
+		{"int64", "int", false},
+		{"int", "int64", true},
+		{"uint32", "int", false},
+		{"uint32", "uint", true},
+		{"uint", "uintptr", false},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expect, fits(byName[tt.from], byName[tt.to]), "%s to %s", tt.from, tt.to)
+	}
+}
@@ -0,0 +1,115 @@
+package main
+
+// header is the first line of every generated file.
+const header = "// Code generated by gensafeconv. DO NOT EDIT.\n\n"
+
+// conversionsTemplate generates the conversion functions and their Batch and Policy methods.
+const conversionsTemplate = header + `package safeconversion
+{{range .}}
+// {{.Func}} safely converts an {{.From.Type}} to {{.To.Type}}.
+// {{.Doc}}
+func {{.Func}}(value {{.From.Type}}) ({{.To.Type}}, error) {
+{{- if .Safe}}
+	return {{.To.Type}}(value), nil
+{{- else}}
+	return convertInteger[{{.To.Type}}]("{{.From.Type}}", "{{.To.Type}}", value)
+{{- end}}
+}
+{{end}}
+{{- range .}}
+// {{.Func}} converts value as {{.Func}} does, recording any error.
+func (b *Batch) {{.Func}}(value {{.From.Type}}) {{.To.Type}} {
+	return batchConvert(b, {{.Func}}, value)
+}
+{{end}}
+{{- range .}}
+// {{.Func}} converts value as {{.Func}} does, applying the policy on failure.
+func (p Policy) {{.Func}}(value {{.From.Type}}) ({{.To.Type}}, error) {
+	return policyConvert(p, {{.Func}}, value)
+}
+{{end}}`
+
+// testsTemplate generates the test, fuzz and benchmark functions.
+const testsTemplate = header + `package safeconversion_test
+
+import (
+	"math"
+	"testing"
+
+	safe "github.com/bsv-blockchain/go-safe-conversion"
+)
+{{range .}}
+// Test{{.Func}} tests the conversion from {{.From.Type}} to {{.To.Type}} at the boundaries of both types.
+func Test{{.Func}}(t *testing.T) {
+	for _, v := range []{{.From.Type}}{ {{- range $i, $v := .Values}}{{if $i}}, {{end}}{{$v}}{{end -}} } {
+		checkConversion(t, safe.{{.Func}}, v)
+	}
+}
+{{end}}
+{{- range .}}
+// Fuzz{{.Func}} validates {{.Func}} with random inputs.
+func Fuzz{{.Func}}(f *testing.F) {
+	{{- $fuzz := fuzzType .From}}
+	{{- range .Values}}
+	f.Add({{$fuzz}}({{.}}))
+	{{- end}}
+	f.Fuzz(func(t *testing.T, v {{$fuzz}}) {
+		checkConversion(t, safe.{{.Func}}, {{if .From.FuzzType}}{{.From.Type}}(v){{else}}v{{end}})
+	})
+}
+{{end}}
+// generatedDifferentials returns the differential cases of the generated conversions.
+func generatedDifferentials() []differential {
+	return []differential{
+	{{- range .}}
+		integerDifferential("{{.Func}}", safe.{{.Func}}),
+	{{- end}}
+	}
+}
+{{range .}}
+// Benchmark{{.Func}} benchmarks the performance of {{.Func}}.
+func Benchmark{{.Func}}(b *testing.B) {
+	var r {{.To.Type}}
+	var err error
+	v := {{.From.Type}}(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.{{.Func}}(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+{{end}}`
+
+// examplesTemplate generates the example functions.
+const examplesTemplate = header + `package safeconversion
+
+import "fmt"
+{{range .}}
+// Example{{.Func}} demonstrates converting an {{.From.Type}} to an {{.To.Type}}.
+func Example{{.Func}}() {
+	v, err := {{.Func}}(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+{{end}}`
+
+// analyzerTemplate generates the analyzer's table of generated functions.
+const analyzerTemplate = header + `package analyzer
+
+// generatedFunctions maps a source and destination type name to the generated
+// safeconversion function that checks it.
+func generatedFunctions() map[[2]string]string {
+	return map[[2]string]string{
+	{{- range .}}{{if not .Safe}}
+		{"{{.From.Type}}", "{{.To.Type}}"}: "{{.Func}}",
+	{{- end}}{{end}}
+	}
+}
+`
@@ -0,0 +1,74 @@
+package safeconversion
+
+import (
+	"fmt"
+	"math"
+)
+
+// Complex128ToFloat64 safely converts a complex128 to the float64 of its real part.
+// Returns an error if the imaginary part is non-zero, or if the real part is NaN or infinite.
+func Complex128ToFloat64(value complex128) (float64, error) {
+	return realPart("float64", value)
+}
+
+// Complex64ToFloat32 safely converts a complex64 to the float32 of its real part.
+// Returns an error if the imaginary part is non-zero, or if the real part is NaN or infinite.
+func Complex64ToFloat32(value complex64) (float32, error) {
+	r, err := realPart("float32", value)
+	if err != nil {
+		return 0, err
+	}
+
+	// The real part of a complex64 is a float32, so the conversion back is exact.
+	return float32(r), nil
+}
+
+// ComplexTo safely converts a complex64 or complex128 to the integer of its real part.
+// Returns an error if the imaginary part is non-zero, or if the real part is NaN, infinite,
+// has a fractional part, or is outside the range of T.
+func ComplexTo[T Integer, C Complex](value C) (T, error) {
+	r, err := realPart(typeName[T](), value)
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := fromFloat64[T](r)
+	if err != nil {
+		return 0, failure(fmt.Sprintf("%T", value), typeName[T](), value, err)
+	}
+
+	return result, nil
+}
+
+// Complex128ToComplex64 safely converts a complex128 to complex64.
+// Returns an error if a finite part overflows to infinity, underflows, or cannot be represented
+// exactly as a float32. NaN and infinite parts are preserved.
+func Complex128ToComplex64(value complex128) (complex64, error) {
+	re, err := narrowFloat64("complex128", "complex64", value, real(value), RoundHalfEven, true)
+	if err != nil {
+		return 0, err
+	}
+
+	im, err := narrowFloat64("complex128", "complex64", value, imag(value), RoundHalfEven, true)
+	if err != nil {
+		return 0, err
+	}
+
+	return complex(re, im), nil
+}
+
+// realPart returns the real part of value, reporting a failure as a conversion to the dst type.
+// Returns an error if the imaginary part is non-zero, or if the real part is NaN or infinite.
+func realPart[C Complex](dst string, value C) (float64, error) {
+	c := complex128(value)
+	if imag(c) != 0 {
+		return 0, failure(fmt.Sprintf("%T", value), dst, value, fmt.Errorf("%w (%s): %v", ErrNonRealValue, dst, value))
+	}
+
+	r := real(c)
+	if math.IsNaN(r) || math.IsInf(r, 0) {
+		return 0, failure(fmt.Sprintf("%T", value), dst, value, fmt.Errorf("%w (%s): %v", ErrValueOutOfRange, dst, value))
+	}
+
+	return r, nil
+}
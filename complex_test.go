@@ -0,0 +1,128 @@
+package safeconversion_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	safe "github.com/bsv-blockchain/go-safe-conversion"
+)
+
+// TestComplex128ToFloat64 tests taking the real part of complex128 values.
+func TestComplex128ToFloat64(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   complex128
+		expect  float64
+		wantErr error
+	}{
+		{zeroValueName, 0, 0, nil},
+		{"real value", complex(-1.5, 0), -1.5, nil},
+		{"negative zero imaginary part", complex(2, math.Copysign(0, -1)), 2, nil},
+		{"imaginary part", complex(2, 1e-300), 0, safe.ErrNonRealValue},
+		{"NaN imaginary part", complex(2, math.NaN()), 0, safe.ErrNonRealValue},
+		{"NaN real part", complex(math.NaN(), 0), 0, safe.ErrValueOutOfRange},
+		{"infinite real part", complex(math.Inf(-1), 0), 0, safe.ErrValueOutOfRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := safe.Complex128ToFloat64(tt.input)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Zero(t, result)
+				return
+			}
+			require.NoError(t, err)
+			assert.InDelta(t, tt.expect, result, 0)
+		})
+	}
+}
+
+// TestComplex64ToFloat32 tests taking the real part of complex64 values.
+func TestComplex64ToFloat32(t *testing.T) {
+	result, err := safe.Complex64ToFloat32(complex(float32(math.MaxFloat32), 0))
+	require.NoError(t, err)
+	assert.InDelta(t, float32(math.MaxFloat32), result, 0)
+
+	_, err = safe.Complex64ToFloat32(complex(1, 1))
+	require.ErrorIs(t, err, safe.ErrNonRealValue)
+
+	var convErr *safe.ConversionError
+	require.ErrorAs(t, err, &convErr)
+	assert.Equal(t, "complex64", convErr.From)
+	assert.Equal(t, "float32", convErr.To)
+	assert.Equal(t, complex64(complex(1, 1)), convErr.Value)
+}
+
+// TestComplexTo tests converting the real part of complex values to integers.
+func TestComplexTo(t *testing.T) {
+	tests := []struct {
+		name    string
+		convert func() (any, error)
+		expect  any
+		wantErr error
+	}{
+		{"int64", func() (any, error) { return safe.ComplexTo[int64](complex(-42, 0)) }, int64(-42), nil},
+		{"uint8 max", func() (any, error) { return safe.ComplexTo[uint8](complex64(complex(255, 0))) }, uint8(255), nil},
+		{"uint8 overflow", func() (any, error) { return safe.ComplexTo[uint8](complex(256, 0)) }, nil, safe.ErrValueOutOfRange},
+		{"negative to unsigned", func() (any, error) { return safe.ComplexTo[uint32](complex(-1, 0)) }, nil, safe.ErrNegativeValueCannotBeConverted},
+		{"fractional", func() (any, error) { return safe.ComplexTo[int32](complex(0.5, 0)) }, nil, safe.ErrFractionalValue},
+		{"imaginary part", func() (any, error) { return safe.ComplexTo[int](complex(1, 1)) }, nil, safe.ErrNonRealValue},
+		{"NaN", func() (any, error) { return safe.ComplexTo[int](complex(math.NaN(), 0)) }, nil, safe.ErrValueOutOfRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.convert()
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+
+				var convErr *safe.ConversionError
+				require.ErrorAs(t, err, &convErr)
+				assert.Equal(t, tt.wantErr.Error(), convErr.Reason())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expect, result)
+		})
+	}
+}
+
+// TestComplex128ToComplex64 tests narrowing complex128 values to complex64.
+func TestComplex128ToComplex64(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   complex128
+		wantErr error
+	}{
+		{zeroValueName, 0, nil},
+		{"exact parts", complex(0.5, -1<<24), nil},
+		{"largest float32", complex(0, math.MaxFloat32), nil},
+		{"NaN and infinity are preserved", complex(math.NaN(), math.Inf(1)), nil},
+		{"real part overflows", complex(math.MaxFloat64, 0), safe.ErrValueOverflow},
+		{"imaginary part overflows", complex(0, -1e39), safe.ErrValueOverflow},
+		{"real part is inexact", complex(0.1, 0), safe.ErrInexactValue},
+		{"imaginary part is inexact", complex(0, 1<<24+1), safe.ErrInexactValue},
+		{"real part underflows", complex(math.SmallestNonzeroFloat64, 0), safe.ErrValueUnderflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := safe.Complex128ToComplex64(tt.input)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Zero(t, result)
+				return
+			}
+			require.NoError(t, err)
+
+			// Parts are compared by bit pattern, since NaN is not equal to itself.
+			expect := complex64(tt.input)
+			assert.Equal(t, math.Float32bits(real(expect)), math.Float32bits(real(result)))
+			assert.Equal(t, math.Float32bits(imag(expect)), math.Float32bits(imag(result)))
+		})
+	}
+}
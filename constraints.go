@@ -0,0 +1,144 @@
+package safeconversion
+
+import (
+	"fmt"
+	"math"
+	"unsafe"
+)
+
+// Signed is a constraint that permits any signed integer type.
+type Signed interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+// Unsigned is a constraint that permits any unsigned integer type.
+type Unsigned interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Integer is a constraint that permits any integer type.
+type Integer interface {
+	Signed | Unsigned
+}
+
+// Complex is a constraint that permits any complex type.
+type Complex interface {
+	~complex64 | ~complex128
+}
+
+// isSigned reports whether T is a signed integer type.
+func isSigned[T Integer]() bool {
+	var zero T
+	return zero-1 < zero
+}
+
+// bitSize returns the width of T in bits.
+func bitSize[T Integer]() int {
+	var zero T
+	return int(unsafe.Sizeof(zero)) * 8
+}
+
+// minOf returns the smallest value representable by T.
+func minOf[T Integer]() T {
+	if !isSigned[T]() {
+		return 0
+	}
+
+	return T(1) << (bitSize[T]() - 1)
+}
+
+// maxOf returns the largest value representable by T.
+func maxOf[T Integer]() T {
+	return ^minOf[T]()
+}
+
+// typeName returns the Go type name of T for use in error messages.
+func typeName[T Integer]() string {
+	var zero T
+	return fmt.Sprintf("%T", zero)
+}
+
+// fromInt64 converts an int64 to T after ensuring it is in range.
+// Returns an error if the value is negative and T is unsigned, or if it exceeds the range of T.
+func fromInt64[T Integer](value int64) (T, error) {
+	if !isSigned[T]() {
+		if value < 0 {
+			return 0, fmt.Errorf("%w to %s: %d", ErrNegativeValueCannotBeConverted, typeName[T](), value)
+		}
+
+		return fromUint64[T](uint64(value))
+	}
+
+	if value < int64(minOf[T]()) || value > int64(maxOf[T]()) {
+		return 0, fmt.Errorf("%w (%s): %d", ErrValueOutOfRange, typeName[T](), value)
+	}
+
+	return T(value), nil
+}
+
+// fromUint64 converts an uint64 to T after ensuring it is in range.
+// Returns an error if the value exceeds the maximum value of T.
+func fromUint64[T Integer](value uint64) (T, error) {
+	if value > toUint64(maxOf[T]()) {
+		return 0, fmt.Errorf("%w (%s): %d", ErrValueOutOfRange, typeName[T](), value)
+	}
+
+	return T(value), nil
+}
+
+// toUint64 widens a value of T to uint64, clamping negative values to zero.
+func toUint64[T Integer](value T) uint64 {
+	if value < 0 {
+		return 0
+	}
+
+	return uint64(value)
+}
+
+// fromFloat64 converts a float64 to T after ensuring it is a whole number in range.
+// Returns an error if the value is NaN, infinite, has a fractional part, or exceeds the range of T.
+func fromFloat64[T Integer](value float64) (T, error) {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return 0, fmt.Errorf("%w (%s): %v", ErrValueOutOfRange, typeName[T](), value)
+	}
+
+	if math.Trunc(value) != value {
+		return 0, fmt.Errorf("%w (%s): %v", ErrFractionalValue, typeName[T](), value)
+	}
+
+	if value < 0 && !isSigned[T]() {
+		return 0, fmt.Errorf("%w to %s: %v", ErrNegativeValueCannotBeConverted, typeName[T](), value)
+	}
+
+	// 1<<64 is the smallest float64 that does not fit in an uint64.
+	if value < math.MinInt64 || value >= 1<<64 {
+		return 0, fmt.Errorf("%w (%s): %v", ErrValueOutOfRange, typeName[T](), value)
+	}
+
+	if value < 0 {
+		return fromInt64[T](int64(value))
+	}
+
+	return fromUint64[T](uint64(value))
+}
+
+// convertInteger converts value to D after ensuring it is in range, reporting a failure
+// as a conversion from the src type to the dst type. It backs the generated conversions.
+func convertInteger[D, S Integer](src, dst string, value S) (D, error) {
+	neg, magnitude := magnitudeOf(value)
+	if neg && !isSigned[D]() {
+		return 0, failure(src, dst, value, fmt.Errorf("%w to %s: %d", ErrNegativeValueCannotBeConverted, dst, value))
+	}
+
+	// The most negative value of a signed type has a magnitude one larger than its maximum.
+	limit := toUint64(maxOf[D]())
+	if neg {
+		limit++
+	}
+
+	if magnitude > limit {
+		return 0, failure(src, dst, value, fmt.Errorf("%w (%s): %d", ErrValueOutOfRange, dst, value))
+	}
+
+	return D(value), nil
+}
@@ -0,0 +1,1395 @@
+// Code generated by gensafeconv. DO NOT EDIT.
+
+package safeconversion
+
+// IntToInt8 safely converts an int to int8.
+// Returns an error if the value is outside the range of an int8.
+func IntToInt8(value int) (int8, error) {
+	return convertInteger[int8]("int", "int8", value)
+}
+
+// IntToInt64 safely converts an int to int64.
+// Since all int values are within the valid int64 range, the conversion is always safe.
+func IntToInt64(value int) (int64, error) {
+	return int64(value), nil
+}
+
+// IntToUint safely converts an int to uint.
+// Returns an error if the value is negative or exceeds the maximum value of an uint, which is 32 bits on 32-bit platforms.
+func IntToUint(value int) (uint, error) {
+	return convertInteger[uint]("int", "uint", value)
+}
+
+// IntToUint8 safely converts an int to uint8.
+// Returns an error if the value is negative or exceeds the maximum value of an uint8.
+func IntToUint8(value int) (uint8, error) {
+	return convertInteger[uint8]("int", "uint8", value)
+}
+
+// Int8ToInt safely converts an int8 to int.
+// Since all int8 values are within the valid int range, the conversion is always safe.
+func Int8ToInt(value int8) (int, error) {
+	return int(value), nil
+}
+
+// Int8ToInt16 safely converts an int8 to int16.
+// Since all int8 values are within the valid int16 range, the conversion is always safe.
+func Int8ToInt16(value int8) (int16, error) {
+	return int16(value), nil
+}
+
+// Int8ToInt32 safely converts an int8 to int32.
+// Since all int8 values are within the valid int32 range, the conversion is always safe.
+func Int8ToInt32(value int8) (int32, error) {
+	return int32(value), nil
+}
+
+// Int8ToInt64 safely converts an int8 to int64.
+// Since all int8 values are within the valid int64 range, the conversion is always safe.
+func Int8ToInt64(value int8) (int64, error) {
+	return int64(value), nil
+}
+
+// Int8ToUint safely converts an int8 to uint.
+// Returns an error if the value is negative.
+func Int8ToUint(value int8) (uint, error) {
+	return convertInteger[uint]("int8", "uint", value)
+}
+
+// Int8ToUint8 safely converts an int8 to uint8.
+// Returns an error if the value is negative.
+func Int8ToUint8(value int8) (uint8, error) {
+	return convertInteger[uint8]("int8", "uint8", value)
+}
+
+// Int8ToUint16 safely converts an int8 to uint16.
+// Returns an error if the value is negative.
+func Int8ToUint16(value int8) (uint16, error) {
+	return convertInteger[uint16]("int8", "uint16", value)
+}
+
+// Int8ToUint32 safely converts an int8 to uint32.
+// Returns an error if the value is negative.
+func Int8ToUint32(value int8) (uint32, error) {
+	return convertInteger[uint32]("int8", "uint32", value)
+}
+
+// Int8ToUint64 safely converts an int8 to uint64.
+// Returns an error if the value is negative.
+func Int8ToUint64(value int8) (uint64, error) {
+	return convertInteger[uint64]("int8", "uint64", value)
+}
+
+// Int8ToUintptr safely converts an int8 to uintptr.
+// Returns an error if the value is negative.
+func Int8ToUintptr(value int8) (uintptr, error) {
+	return convertInteger[uintptr]("int8", "uintptr", value)
+}
+
+// Int16ToInt safely converts an int16 to int.
+// Since all int16 values are within the valid int range, the conversion is always safe.
+func Int16ToInt(value int16) (int, error) {
+	return int(value), nil
+}
+
+// Int16ToInt8 safely converts an int16 to int8.
+// Returns an error if the value is outside the range of an int8.
+func Int16ToInt8(value int16) (int8, error) {
+	return convertInteger[int8]("int16", "int8", value)
+}
+
+// Int16ToInt32 safely converts an int16 to int32.
+// Since all int16 values are within the valid int32 range, the conversion is always safe.
+func Int16ToInt32(value int16) (int32, error) {
+	return int32(value), nil
+}
+
+// Int16ToInt64 safely converts an int16 to int64.
+// Since all int16 values are within the valid int64 range, the conversion is always safe.
+func Int16ToInt64(value int16) (int64, error) {
+	return int64(value), nil
+}
+
+// Int16ToUint safely converts an int16 to uint.
+// Returns an error if the value is negative.
+func Int16ToUint(value int16) (uint, error) {
+	return convertInteger[uint]("int16", "uint", value)
+}
+
+// Int16ToUint8 safely converts an int16 to uint8.
+// Returns an error if the value is negative or exceeds the maximum value of an uint8.
+func Int16ToUint8(value int16) (uint8, error) {
+	return convertInteger[uint8]("int16", "uint8", value)
+}
+
+// Int16ToUint16 safely converts an int16 to uint16.
+// Returns an error if the value is negative.
+func Int16ToUint16(value int16) (uint16, error) {
+	return convertInteger[uint16]("int16", "uint16", value)
+}
+
+// Int16ToUint32 safely converts an int16 to uint32.
+// Returns an error if the value is negative.
+func Int16ToUint32(value int16) (uint32, error) {
+	return convertInteger[uint32]("int16", "uint32", value)
+}
+
+// Int16ToUint64 safely converts an int16 to uint64.
+// Returns an error if the value is negative.
+func Int16ToUint64(value int16) (uint64, error) {
+	return convertInteger[uint64]("int16", "uint64", value)
+}
+
+// Int16ToUintptr safely converts an int16 to uintptr.
+// Returns an error if the value is negative.
+func Int16ToUintptr(value int16) (uintptr, error) {
+	return convertInteger[uintptr]("int16", "uintptr", value)
+}
+
+// Int32ToInt safely converts an int32 to int.
+// Since all int32 values are within the valid int range, the conversion is always safe.
+func Int32ToInt(value int32) (int, error) {
+	return int(value), nil
+}
+
+// Int32ToInt8 safely converts an int32 to int8.
+// Returns an error if the value is outside the range of an int8.
+func Int32ToInt8(value int32) (int8, error) {
+	return convertInteger[int8]("int32", "int8", value)
+}
+
+// Int32ToInt16 safely converts an int32 to int16.
+// Returns an error if the value is outside the range of an int16.
+func Int32ToInt16(value int32) (int16, error) {
+	return convertInteger[int16]("int32", "int16", value)
+}
+
+// Int32ToInt64 safely converts an int32 to int64.
+// Since all int32 values are within the valid int64 range, the conversion is always safe.
+func Int32ToInt64(value int32) (int64, error) {
+	return int64(value), nil
+}
+
+// Int32ToUint safely converts an int32 to uint.
+// Returns an error if the value is negative.
+func Int32ToUint(value int32) (uint, error) {
+	return convertInteger[uint]("int32", "uint", value)
+}
+
+// Int32ToUint8 safely converts an int32 to uint8.
+// Returns an error if the value is negative or exceeds the maximum value of an uint8.
+func Int32ToUint8(value int32) (uint8, error) {
+	return convertInteger[uint8]("int32", "uint8", value)
+}
+
+// Int32ToUint16 safely converts an int32 to uint16.
+// Returns an error if the value is negative or exceeds the maximum value of an uint16.
+func Int32ToUint16(value int32) (uint16, error) {
+	return convertInteger[uint16]("int32", "uint16", value)
+}
+
+// Int32ToUint64 safely converts an int32 to uint64.
+// Returns an error if the value is negative.
+func Int32ToUint64(value int32) (uint64, error) {
+	return convertInteger[uint64]("int32", "uint64", value)
+}
+
+// Int32ToUintptr safely converts an int32 to uintptr.
+// Returns an error if the value is negative.
+func Int32ToUintptr(value int32) (uintptr, error) {
+	return convertInteger[uintptr]("int32", "uintptr", value)
+}
+
+// Int64ToInt safely converts an int64 to int.
+// Returns an error if the value is outside the range of an int, which is 32 bits on 32-bit platforms.
+func Int64ToInt(value int64) (int, error) {
+	return convertInteger[int]("int64", "int", value)
+}
+
+// Int64ToInt8 safely converts an int64 to int8.
+// Returns an error if the value is outside the range of an int8.
+func Int64ToInt8(value int64) (int8, error) {
+	return convertInteger[int8]("int64", "int8", value)
+}
+
+// Int64ToInt16 safely converts an int64 to int16.
+// Returns an error if the value is outside the range of an int16.
+func Int64ToInt16(value int64) (int16, error) {
+	return convertInteger[int16]("int64", "int16", value)
+}
+
+// Int64ToUint safely converts an int64 to uint.
+// Returns an error if the value is negative or exceeds the maximum value of an uint, which is 32 bits on 32-bit platforms.
+func Int64ToUint(value int64) (uint, error) {
+	return convertInteger[uint]("int64", "uint", value)
+}
+
+// Int64ToUint8 safely converts an int64 to uint8.
+// Returns an error if the value is negative or exceeds the maximum value of an uint8.
+func Int64ToUint8(value int64) (uint8, error) {
+	return convertInteger[uint8]("int64", "uint8", value)
+}
+
+// Int64ToUint16 safely converts an int64 to uint16.
+// Returns an error if the value is negative or exceeds the maximum value of an uint16.
+func Int64ToUint16(value int64) (uint16, error) {
+	return convertInteger[uint16]("int64", "uint16", value)
+}
+
+// UintToInt safely converts an uint to int.
+// Returns an error if the value exceeds the maximum value of an int, which is 32 bits on 32-bit platforms.
+func UintToInt(value uint) (int, error) {
+	return convertInteger[int]("uint", "int", value)
+}
+
+// UintToInt8 safely converts an uint to int8.
+// Returns an error if the value exceeds the maximum value of an int8.
+func UintToInt8(value uint) (int8, error) {
+	return convertInteger[int8]("uint", "int8", value)
+}
+
+// UintToInt16 safely converts an uint to int16.
+// Returns an error if the value exceeds the maximum value of an int16.
+func UintToInt16(value uint) (int16, error) {
+	return convertInteger[int16]("uint", "int16", value)
+}
+
+// UintToInt32 safely converts an uint to int32.
+// Returns an error if the value exceeds the maximum value of an int32.
+func UintToInt32(value uint) (int32, error) {
+	return convertInteger[int32]("uint", "int32", value)
+}
+
+// UintToInt64 safely converts an uint to int64.
+// Returns an error if the value exceeds the maximum value of an int64.
+func UintToInt64(value uint) (int64, error) {
+	return convertInteger[int64]("uint", "int64", value)
+}
+
+// UintToUint8 safely converts an uint to uint8.
+// Returns an error if the value exceeds the maximum value of an uint8.
+func UintToUint8(value uint) (uint8, error) {
+	return convertInteger[uint8]("uint", "uint8", value)
+}
+
+// UintToUint16 safely converts an uint to uint16.
+// Returns an error if the value exceeds the maximum value of an uint16.
+func UintToUint16(value uint) (uint16, error) {
+	return convertInteger[uint16]("uint", "uint16", value)
+}
+
+// UintToUint64 safely converts an uint to uint64.
+// Since all uint values are within the valid uint64 range, the conversion is always safe.
+func UintToUint64(value uint) (uint64, error) {
+	return uint64(value), nil
+}
+
+// UintToUintptr safely converts an uint to uintptr.
+// Returns an error if the value exceeds the maximum value of an uintptr, which is 32 bits on 32-bit platforms.
+func UintToUintptr(value uint) (uintptr, error) {
+	return convertInteger[uintptr]("uint", "uintptr", value)
+}
+
+// Uint8ToInt safely converts an uint8 to int.
+// Since all uint8 values are within the valid int range, the conversion is always safe.
+func Uint8ToInt(value uint8) (int, error) {
+	return int(value), nil
+}
+
+// Uint8ToInt8 safely converts an uint8 to int8.
+// Returns an error if the value exceeds the maximum value of an int8.
+func Uint8ToInt8(value uint8) (int8, error) {
+	return convertInteger[int8]("uint8", "int8", value)
+}
+
+// Uint8ToInt16 safely converts an uint8 to int16.
+// Since all uint8 values are within the valid int16 range, the conversion is always safe.
+func Uint8ToInt16(value uint8) (int16, error) {
+	return int16(value), nil
+}
+
+// Uint8ToInt32 safely converts an uint8 to int32.
+// Since all uint8 values are within the valid int32 range, the conversion is always safe.
+func Uint8ToInt32(value uint8) (int32, error) {
+	return int32(value), nil
+}
+
+// Uint8ToInt64 safely converts an uint8 to int64.
+// Since all uint8 values are within the valid int64 range, the conversion is always safe.
+func Uint8ToInt64(value uint8) (int64, error) {
+	return int64(value), nil
+}
+
+// Uint8ToUint safely converts an uint8 to uint.
+// Since all uint8 values are within the valid uint range, the conversion is always safe.
+func Uint8ToUint(value uint8) (uint, error) {
+	return uint(value), nil
+}
+
+// Uint8ToUint16 safely converts an uint8 to uint16.
+// Since all uint8 values are within the valid uint16 range, the conversion is always safe.
+func Uint8ToUint16(value uint8) (uint16, error) {
+	return uint16(value), nil
+}
+
+// Uint8ToUint32 safely converts an uint8 to uint32.
+// Since all uint8 values are within the valid uint32 range, the conversion is always safe.
+func Uint8ToUint32(value uint8) (uint32, error) {
+	return uint32(value), nil
+}
+
+// Uint8ToUint64 safely converts an uint8 to uint64.
+// Since all uint8 values are within the valid uint64 range, the conversion is always safe.
+func Uint8ToUint64(value uint8) (uint64, error) {
+	return uint64(value), nil
+}
+
+// Uint8ToUintptr safely converts an uint8 to uintptr.
+// Since all uint8 values are within the valid uintptr range, the conversion is always safe.
+func Uint8ToUintptr(value uint8) (uintptr, error) {
+	return uintptr(value), nil
+}
+
+// Uint16ToInt safely converts an uint16 to int.
+// Since all uint16 values are within the valid int range, the conversion is always safe.
+func Uint16ToInt(value uint16) (int, error) {
+	return int(value), nil
+}
+
+// Uint16ToInt8 safely converts an uint16 to int8.
+// Returns an error if the value exceeds the maximum value of an int8.
+func Uint16ToInt8(value uint16) (int8, error) {
+	return convertInteger[int8]("uint16", "int8", value)
+}
+
+// Uint16ToInt16 safely converts an uint16 to int16.
+// Returns an error if the value exceeds the maximum value of an int16.
+func Uint16ToInt16(value uint16) (int16, error) {
+	return convertInteger[int16]("uint16", "int16", value)
+}
+
+// Uint16ToInt32 safely converts an uint16 to int32.
+// Since all uint16 values are within the valid int32 range, the conversion is always safe.
+func Uint16ToInt32(value uint16) (int32, error) {
+	return int32(value), nil
+}
+
+// Uint16ToInt64 safely converts an uint16 to int64.
+// Since all uint16 values are within the valid int64 range, the conversion is always safe.
+func Uint16ToInt64(value uint16) (int64, error) {
+	return int64(value), nil
+}
+
+// Uint16ToUint safely converts an uint16 to uint.
+// Since all uint16 values are within the valid uint range, the conversion is always safe.
+func Uint16ToUint(value uint16) (uint, error) {
+	return uint(value), nil
+}
+
+// Uint16ToUint8 safely converts an uint16 to uint8.
+// Returns an error if the value exceeds the maximum value of an uint8.
+func Uint16ToUint8(value uint16) (uint8, error) {
+	return convertInteger[uint8]("uint16", "uint8", value)
+}
+
+// Uint16ToUint32 safely converts an uint16 to uint32.
+// Since all uint16 values are within the valid uint32 range, the conversion is always safe.
+func Uint16ToUint32(value uint16) (uint32, error) {
+	return uint32(value), nil
+}
+
+// Uint16ToUint64 safely converts an uint16 to uint64.
+// Since all uint16 values are within the valid uint64 range, the conversion is always safe.
+func Uint16ToUint64(value uint16) (uint64, error) {
+	return uint64(value), nil
+}
+
+// Uint16ToUintptr safely converts an uint16 to uintptr.
+// Since all uint16 values are within the valid uintptr range, the conversion is always safe.
+func Uint16ToUintptr(value uint16) (uintptr, error) {
+	return uintptr(value), nil
+}
+
+// Uint32ToInt safely converts an uint32 to int.
+// Returns an error if the value exceeds the maximum value of an int, which is 32 bits on 32-bit platforms.
+func Uint32ToInt(value uint32) (int, error) {
+	return convertInteger[int]("uint32", "int", value)
+}
+
+// Uint32ToInt8 safely converts an uint32 to int8.
+// Returns an error if the value exceeds the maximum value of an int8.
+func Uint32ToInt8(value uint32) (int8, error) {
+	return convertInteger[int8]("uint32", "int8", value)
+}
+
+// Uint32ToInt16 safely converts an uint32 to int16.
+// Returns an error if the value exceeds the maximum value of an int16.
+func Uint32ToInt16(value uint32) (int16, error) {
+	return convertInteger[int16]("uint32", "int16", value)
+}
+
+// Uint32ToUint safely converts an uint32 to uint.
+// Since all uint32 values are within the valid uint range, the conversion is always safe.
+func Uint32ToUint(value uint32) (uint, error) {
+	return uint(value), nil
+}
+
+// Uint32ToUint16 safely converts an uint32 to uint16.
+// Returns an error if the value exceeds the maximum value of an uint16.
+func Uint32ToUint16(value uint32) (uint16, error) {
+	return convertInteger[uint16]("uint32", "uint16", value)
+}
+
+// Uint32ToUintptr safely converts an uint32 to uintptr.
+// Since all uint32 values are within the valid uintptr range, the conversion is always safe.
+func Uint32ToUintptr(value uint32) (uintptr, error) {
+	return uintptr(value), nil
+}
+
+// Uint64ToInt8 safely converts an uint64 to int8.
+// Returns an error if the value exceeds the maximum value of an int8.
+func Uint64ToInt8(value uint64) (int8, error) {
+	return convertInteger[int8]("uint64", "int8", value)
+}
+
+// Uint64ToInt16 safely converts an uint64 to int16.
+// Returns an error if the value exceeds the maximum value of an int16.
+func Uint64ToInt16(value uint64) (int16, error) {
+	return convertInteger[int16]("uint64", "int16", value)
+}
+
+// Uint64ToUint safely converts an uint64 to uint.
+// Returns an error if the value exceeds the maximum value of an uint, which is 32 bits on 32-bit platforms.
+func Uint64ToUint(value uint64) (uint, error) {
+	return convertInteger[uint]("uint64", "uint", value)
+}
+
+// Uint64ToUint8 safely converts an uint64 to uint8.
+// Returns an error if the value exceeds the maximum value of an uint8.
+func Uint64ToUint8(value uint64) (uint8, error) {
+	return convertInteger[uint8]("uint64", "uint8", value)
+}
+
+// UintptrToInt8 safely converts an uintptr to int8.
+// Returns an error if the value exceeds the maximum value of an int8.
+func UintptrToInt8(value uintptr) (int8, error) {
+	return convertInteger[int8]("uintptr", "int8", value)
+}
+
+// UintptrToInt16 safely converts an uintptr to int16.
+// Returns an error if the value exceeds the maximum value of an int16.
+func UintptrToInt16(value uintptr) (int16, error) {
+	return convertInteger[int16]("uintptr", "int16", value)
+}
+
+// UintptrToInt32 safely converts an uintptr to int32.
+// Returns an error if the value exceeds the maximum value of an int32.
+func UintptrToInt32(value uintptr) (int32, error) {
+	return convertInteger[int32]("uintptr", "int32", value)
+}
+
+// UintptrToInt64 safely converts an uintptr to int64.
+// Returns an error if the value exceeds the maximum value of an int64.
+func UintptrToInt64(value uintptr) (int64, error) {
+	return convertInteger[int64]("uintptr", "int64", value)
+}
+
+// UintptrToUint safely converts an uintptr to uint.
+// Returns an error if the value exceeds the maximum value of an uint, which is 32 bits on 32-bit platforms.
+func UintptrToUint(value uintptr) (uint, error) {
+	return convertInteger[uint]("uintptr", "uint", value)
+}
+
+// UintptrToUint8 safely converts an uintptr to uint8.
+// Returns an error if the value exceeds the maximum value of an uint8.
+func UintptrToUint8(value uintptr) (uint8, error) {
+	return convertInteger[uint8]("uintptr", "uint8", value)
+}
+
+// UintptrToUint16 safely converts an uintptr to uint16.
+// Returns an error if the value exceeds the maximum value of an uint16.
+func UintptrToUint16(value uintptr) (uint16, error) {
+	return convertInteger[uint16]("uintptr", "uint16", value)
+}
+
+// UintptrToUint32 safely converts an uintptr to uint32.
+// Returns an error if the value exceeds the maximum value of an uint32.
+func UintptrToUint32(value uintptr) (uint32, error) {
+	return convertInteger[uint32]("uintptr", "uint32", value)
+}
+
+// UintptrToUint64 safely converts an uintptr to uint64.
+// Since all uintptr values are within the valid uint64 range, the conversion is always safe.
+func UintptrToUint64(value uintptr) (uint64, error) {
+	return uint64(value), nil
+}
+
+// IntToInt8 converts value as IntToInt8 does, recording any error.
+func (b *Batch) IntToInt8(value int) int8 {
+	return batchConvert(b, IntToInt8, value)
+}
+
+// IntToInt64 converts value as IntToInt64 does, recording any error.
+func (b *Batch) IntToInt64(value int) int64 {
+	return batchConvert(b, IntToInt64, value)
+}
+
+// IntToUint converts value as IntToUint does, recording any error.
+func (b *Batch) IntToUint(value int) uint {
+	return batchConvert(b, IntToUint, value)
+}
+
+// IntToUint8 converts value as IntToUint8 does, recording any error.
+func (b *Batch) IntToUint8(value int) uint8 {
+	return batchConvert(b, IntToUint8, value)
+}
+
+// Int8ToInt converts value as Int8ToInt does, recording any error.
+func (b *Batch) Int8ToInt(value int8) int {
+	return batchConvert(b, Int8ToInt, value)
+}
+
+// Int8ToInt16 converts value as Int8ToInt16 does, recording any error.
+func (b *Batch) Int8ToInt16(value int8) int16 {
+	return batchConvert(b, Int8ToInt16, value)
+}
+
+// Int8ToInt32 converts value as Int8ToInt32 does, recording any error.
+func (b *Batch) Int8ToInt32(value int8) int32 {
+	return batchConvert(b, Int8ToInt32, value)
+}
+
+// Int8ToInt64 converts value as Int8ToInt64 does, recording any error.
+func (b *Batch) Int8ToInt64(value int8) int64 {
+	return batchConvert(b, Int8ToInt64, value)
+}
+
+// Int8ToUint converts value as Int8ToUint does, recording any error.
+func (b *Batch) Int8ToUint(value int8) uint {
+	return batchConvert(b, Int8ToUint, value)
+}
+
+// Int8ToUint8 converts value as Int8ToUint8 does, recording any error.
+func (b *Batch) Int8ToUint8(value int8) uint8 {
+	return batchConvert(b, Int8ToUint8, value)
+}
+
+// Int8ToUint16 converts value as Int8ToUint16 does, recording any error.
+func (b *Batch) Int8ToUint16(value int8) uint16 {
+	return batchConvert(b, Int8ToUint16, value)
+}
+
+// Int8ToUint32 converts value as Int8ToUint32 does, recording any error.
+func (b *Batch) Int8ToUint32(value int8) uint32 {
+	return batchConvert(b, Int8ToUint32, value)
+}
+
+// Int8ToUint64 converts value as Int8ToUint64 does, recording any error.
+func (b *Batch) Int8ToUint64(value int8) uint64 {
+	return batchConvert(b, Int8ToUint64, value)
+}
+
+// Int8ToUintptr converts value as Int8ToUintptr does, recording any error.
+func (b *Batch) Int8ToUintptr(value int8) uintptr {
+	return batchConvert(b, Int8ToUintptr, value)
+}
+
+// Int16ToInt converts value as Int16ToInt does, recording any error.
+func (b *Batch) Int16ToInt(value int16) int {
+	return batchConvert(b, Int16ToInt, value)
+}
+
+// Int16ToInt8 converts value as Int16ToInt8 does, recording any error.
+func (b *Batch) Int16ToInt8(value int16) int8 {
+	return batchConvert(b, Int16ToInt8, value)
+}
+
+// Int16ToInt32 converts value as Int16ToInt32 does, recording any error.
+func (b *Batch) Int16ToInt32(value int16) int32 {
+	return batchConvert(b, Int16ToInt32, value)
+}
+
+// Int16ToInt64 converts value as Int16ToInt64 does, recording any error.
+func (b *Batch) Int16ToInt64(value int16) int64 {
+	return batchConvert(b, Int16ToInt64, value)
+}
+
+// Int16ToUint converts value as Int16ToUint does, recording any error.
+func (b *Batch) Int16ToUint(value int16) uint {
+	return batchConvert(b, Int16ToUint, value)
+}
+
+// Int16ToUint8 converts value as Int16ToUint8 does, recording any error.
+func (b *Batch) Int16ToUint8(value int16) uint8 {
+	return batchConvert(b, Int16ToUint8, value)
+}
+
+// Int16ToUint16 converts value as Int16ToUint16 does, recording any error.
+func (b *Batch) Int16ToUint16(value int16) uint16 {
+	return batchConvert(b, Int16ToUint16, value)
+}
+
+// Int16ToUint32 converts value as Int16ToUint32 does, recording any error.
+func (b *Batch) Int16ToUint32(value int16) uint32 {
+	return batchConvert(b, Int16ToUint32, value)
+}
+
+// Int16ToUint64 converts value as Int16ToUint64 does, recording any error.
+func (b *Batch) Int16ToUint64(value int16) uint64 {
+	return batchConvert(b, Int16ToUint64, value)
+}
+
+// Int16ToUintptr converts value as Int16ToUintptr does, recording any error.
+func (b *Batch) Int16ToUintptr(value int16) uintptr {
+	return batchConvert(b, Int16ToUintptr, value)
+}
+
+// Int32ToInt converts value as Int32ToInt does, recording any error.
+func (b *Batch) Int32ToInt(value int32) int {
+	return batchConvert(b, Int32ToInt, value)
+}
+
+// Int32ToInt8 converts value as Int32ToInt8 does, recording any error.
+func (b *Batch) Int32ToInt8(value int32) int8 {
+	return batchConvert(b, Int32ToInt8, value)
+}
+
+// Int32ToInt16 converts value as Int32ToInt16 does, recording any error.
+func (b *Batch) Int32ToInt16(value int32) int16 {
+	return batchConvert(b, Int32ToInt16, value)
+}
+
+// Int32ToInt64 converts value as Int32ToInt64 does, recording any error.
+func (b *Batch) Int32ToInt64(value int32) int64 {
+	return batchConvert(b, Int32ToInt64, value)
+}
+
+// Int32ToUint converts value as Int32ToUint does, recording any error.
+func (b *Batch) Int32ToUint(value int32) uint {
+	return batchConvert(b, Int32ToUint, value)
+}
+
+// Int32ToUint8 converts value as Int32ToUint8 does, recording any error.
+func (b *Batch) Int32ToUint8(value int32) uint8 {
+	return batchConvert(b, Int32ToUint8, value)
+}
+
+// Int32ToUint16 converts value as Int32ToUint16 does, recording any error.
+func (b *Batch) Int32ToUint16(value int32) uint16 {
+	return batchConvert(b, Int32ToUint16, value)
+}
+
+// Int32ToUint64 converts value as Int32ToUint64 does, recording any error.
+func (b *Batch) Int32ToUint64(value int32) uint64 {
+	return batchConvert(b, Int32ToUint64, value)
+}
+
+// Int32ToUintptr converts value as Int32ToUintptr does, recording any error.
+func (b *Batch) Int32ToUintptr(value int32) uintptr {
+	return batchConvert(b, Int32ToUintptr, value)
+}
+
+// Int64ToInt converts value as Int64ToInt does, recording any error.
+func (b *Batch) Int64ToInt(value int64) int {
+	return batchConvert(b, Int64ToInt, value)
+}
+
+// Int64ToInt8 converts value as Int64ToInt8 does, recording any error.
+func (b *Batch) Int64ToInt8(value int64) int8 {
+	return batchConvert(b, Int64ToInt8, value)
+}
+
+// Int64ToInt16 converts value as Int64ToInt16 does, recording any error.
+func (b *Batch) Int64ToInt16(value int64) int16 {
+	return batchConvert(b, Int64ToInt16, value)
+}
+
+// Int64ToUint converts value as Int64ToUint does, recording any error.
+func (b *Batch) Int64ToUint(value int64) uint {
+	return batchConvert(b, Int64ToUint, value)
+}
+
+// Int64ToUint8 converts value as Int64ToUint8 does, recording any error.
+func (b *Batch) Int64ToUint8(value int64) uint8 {
+	return batchConvert(b, Int64ToUint8, value)
+}
+
+// Int64ToUint16 converts value as Int64ToUint16 does, recording any error.
+func (b *Batch) Int64ToUint16(value int64) uint16 {
+	return batchConvert(b, Int64ToUint16, value)
+}
+
+// UintToInt converts value as UintToInt does, recording any error.
+func (b *Batch) UintToInt(value uint) int {
+	return batchConvert(b, UintToInt, value)
+}
+
+// UintToInt8 converts value as UintToInt8 does, recording any error.
+func (b *Batch) UintToInt8(value uint) int8 {
+	return batchConvert(b, UintToInt8, value)
+}
+
+// UintToInt16 converts value as UintToInt16 does, recording any error.
+func (b *Batch) UintToInt16(value uint) int16 {
+	return batchConvert(b, UintToInt16, value)
+}
+
+// UintToInt32 converts value as UintToInt32 does, recording any error.
+func (b *Batch) UintToInt32(value uint) int32 {
+	return batchConvert(b, UintToInt32, value)
+}
+
+// UintToInt64 converts value as UintToInt64 does, recording any error.
+func (b *Batch) UintToInt64(value uint) int64 {
+	return batchConvert(b, UintToInt64, value)
+}
+
+// UintToUint8 converts value as UintToUint8 does, recording any error.
+func (b *Batch) UintToUint8(value uint) uint8 {
+	return batchConvert(b, UintToUint8, value)
+}
+
+// UintToUint16 converts value as UintToUint16 does, recording any error.
+func (b *Batch) UintToUint16(value uint) uint16 {
+	return batchConvert(b, UintToUint16, value)
+}
+
+// UintToUint64 converts value as UintToUint64 does, recording any error.
+func (b *Batch) UintToUint64(value uint) uint64 {
+	return batchConvert(b, UintToUint64, value)
+}
+
+// UintToUintptr converts value as UintToUintptr does, recording any error.
+func (b *Batch) UintToUintptr(value uint) uintptr {
+	return batchConvert(b, UintToUintptr, value)
+}
+
+// Uint8ToInt converts value as Uint8ToInt does, recording any error.
+func (b *Batch) Uint8ToInt(value uint8) int {
+	return batchConvert(b, Uint8ToInt, value)
+}
+
+// Uint8ToInt8 converts value as Uint8ToInt8 does, recording any error.
+func (b *Batch) Uint8ToInt8(value uint8) int8 {
+	return batchConvert(b, Uint8ToInt8, value)
+}
+
+// Uint8ToInt16 converts value as Uint8ToInt16 does, recording any error.
+func (b *Batch) Uint8ToInt16(value uint8) int16 {
+	return batchConvert(b, Uint8ToInt16, value)
+}
+
+// Uint8ToInt32 converts value as Uint8ToInt32 does, recording any error.
+func (b *Batch) Uint8ToInt32(value uint8) int32 {
+	return batchConvert(b, Uint8ToInt32, value)
+}
+
+// Uint8ToInt64 converts value as Uint8ToInt64 does, recording any error.
+func (b *Batch) Uint8ToInt64(value uint8) int64 {
+	return batchConvert(b, Uint8ToInt64, value)
+}
+
+// Uint8ToUint converts value as Uint8ToUint does, recording any error.
+func (b *Batch) Uint8ToUint(value uint8) uint {
+	return batchConvert(b, Uint8ToUint, value)
+}
+
+// Uint8ToUint16 converts value as Uint8ToUint16 does, recording any error.
+func (b *Batch) Uint8ToUint16(value uint8) uint16 {
+	return batchConvert(b, Uint8ToUint16, value)
+}
+
+// Uint8ToUint32 converts value as Uint8ToUint32 does, recording any error.
+func (b *Batch) Uint8ToUint32(value uint8) uint32 {
+	return batchConvert(b, Uint8ToUint32, value)
+}
+
+// Uint8ToUint64 converts value as Uint8ToUint64 does, recording any error.
+func (b *Batch) Uint8ToUint64(value uint8) uint64 {
+	return batchConvert(b, Uint8ToUint64, value)
+}
+
+// Uint8ToUintptr converts value as Uint8ToUintptr does, recording any error.
+func (b *Batch) Uint8ToUintptr(value uint8) uintptr {
+	return batchConvert(b, Uint8ToUintptr, value)
+}
+
+// Uint16ToInt converts value as Uint16ToInt does, recording any error.
+func (b *Batch) Uint16ToInt(value uint16) int {
+	return batchConvert(b, Uint16ToInt, value)
+}
+
+// Uint16ToInt8 converts value as Uint16ToInt8 does, recording any error.
+func (b *Batch) Uint16ToInt8(value uint16) int8 {
+	return batchConvert(b, Uint16ToInt8, value)
+}
+
+// Uint16ToInt16 converts value as Uint16ToInt16 does, recording any error.
+func (b *Batch) Uint16ToInt16(value uint16) int16 {
+	return batchConvert(b, Uint16ToInt16, value)
+}
+
+// Uint16ToInt32 converts value as Uint16ToInt32 does, recording any error.
+func (b *Batch) Uint16ToInt32(value uint16) int32 {
+	return batchConvert(b, Uint16ToInt32, value)
+}
+
+// Uint16ToInt64 converts value as Uint16ToInt64 does, recording any error.
+func (b *Batch) Uint16ToInt64(value uint16) int64 {
+	return batchConvert(b, Uint16ToInt64, value)
+}
+
+// Uint16ToUint converts value as Uint16ToUint does, recording any error.
+func (b *Batch) Uint16ToUint(value uint16) uint {
+	return batchConvert(b, Uint16ToUint, value)
+}
+
+// Uint16ToUint8 converts value as Uint16ToUint8 does, recording any error.
+func (b *Batch) Uint16ToUint8(value uint16) uint8 {
+	return batchConvert(b, Uint16ToUint8, value)
+}
+
+// Uint16ToUint32 converts value as Uint16ToUint32 does, recording any error.
+func (b *Batch) Uint16ToUint32(value uint16) uint32 {
+	return batchConvert(b, Uint16ToUint32, value)
+}
+
+// Uint16ToUint64 converts value as Uint16ToUint64 does, recording any error.
+func (b *Batch) Uint16ToUint64(value uint16) uint64 {
+	return batchConvert(b, Uint16ToUint64, value)
+}
+
+// Uint16ToUintptr converts value as Uint16ToUintptr does, recording any error.
+func (b *Batch) Uint16ToUintptr(value uint16) uintptr {
+	return batchConvert(b, Uint16ToUintptr, value)
+}
+
+// Uint32ToInt converts value as Uint32ToInt does, recording any error.
+func (b *Batch) Uint32ToInt(value uint32) int {
+	return batchConvert(b, Uint32ToInt, value)
+}
+
+// Uint32ToInt8 converts value as Uint32ToInt8 does, recording any error.
+func (b *Batch) Uint32ToInt8(value uint32) int8 {
+	return batchConvert(b, Uint32ToInt8, value)
+}
+
+// Uint32ToInt16 converts value as Uint32ToInt16 does, recording any error.
+func (b *Batch) Uint32ToInt16(value uint32) int16 {
+	return batchConvert(b, Uint32ToInt16, value)
+}
+
+// Uint32ToUint converts value as Uint32ToUint does, recording any error.
+func (b *Batch) Uint32ToUint(value uint32) uint {
+	return batchConvert(b, Uint32ToUint, value)
+}
+
+// Uint32ToUint16 converts value as Uint32ToUint16 does, recording any error.
+func (b *Batch) Uint32ToUint16(value uint32) uint16 {
+	return batchConvert(b, Uint32ToUint16, value)
+}
+
+// Uint32ToUintptr converts value as Uint32ToUintptr does, recording any error.
+func (b *Batch) Uint32ToUintptr(value uint32) uintptr {
+	return batchConvert(b, Uint32ToUintptr, value)
+}
+
+// Uint64ToInt8 converts value as Uint64ToInt8 does, recording any error.
+func (b *Batch) Uint64ToInt8(value uint64) int8 {
+	return batchConvert(b, Uint64ToInt8, value)
+}
+
+// Uint64ToInt16 converts value as Uint64ToInt16 does, recording any error.
+func (b *Batch) Uint64ToInt16(value uint64) int16 {
+	return batchConvert(b, Uint64ToInt16, value)
+}
+
+// Uint64ToUint converts value as Uint64ToUint does, recording any error.
+func (b *Batch) Uint64ToUint(value uint64) uint {
+	return batchConvert(b, Uint64ToUint, value)
+}
+
+// Uint64ToUint8 converts value as Uint64ToUint8 does, recording any error.
+func (b *Batch) Uint64ToUint8(value uint64) uint8 {
+	return batchConvert(b, Uint64ToUint8, value)
+}
+
+// UintptrToInt8 converts value as UintptrToInt8 does, recording any error.
+func (b *Batch) UintptrToInt8(value uintptr) int8 {
+	return batchConvert(b, UintptrToInt8, value)
+}
+
+// UintptrToInt16 converts value as UintptrToInt16 does, recording any error.
+func (b *Batch) UintptrToInt16(value uintptr) int16 {
+	return batchConvert(b, UintptrToInt16, value)
+}
+
+// UintptrToInt32 converts value as UintptrToInt32 does, recording any error.
+func (b *Batch) UintptrToInt32(value uintptr) int32 {
+	return batchConvert(b, UintptrToInt32, value)
+}
+
+// UintptrToInt64 converts value as UintptrToInt64 does, recording any error.
+func (b *Batch) UintptrToInt64(value uintptr) int64 {
+	return batchConvert(b, UintptrToInt64, value)
+}
+
+// UintptrToUint converts value as UintptrToUint does, recording any error.
+func (b *Batch) UintptrToUint(value uintptr) uint {
+	return batchConvert(b, UintptrToUint, value)
+}
+
+// UintptrToUint8 converts value as UintptrToUint8 does, recording any error.
+func (b *Batch) UintptrToUint8(value uintptr) uint8 {
+	return batchConvert(b, UintptrToUint8, value)
+}
+
+// UintptrToUint16 converts value as UintptrToUint16 does, recording any error.
+func (b *Batch) UintptrToUint16(value uintptr) uint16 {
+	return batchConvert(b, UintptrToUint16, value)
+}
+
+// UintptrToUint32 converts value as UintptrToUint32 does, recording any error.
+func (b *Batch) UintptrToUint32(value uintptr) uint32 {
+	return batchConvert(b, UintptrToUint32, value)
+}
+
+// UintptrToUint64 converts value as UintptrToUint64 does, recording any error.
+func (b *Batch) UintptrToUint64(value uintptr) uint64 {
+	return batchConvert(b, UintptrToUint64, value)
+}
+
+// IntToInt8 converts value as IntToInt8 does, applying the policy on failure.
+func (p Policy) IntToInt8(value int) (int8, error) {
+	return policyConvert(p, IntToInt8, value)
+}
+
+// IntToInt64 converts value as IntToInt64 does, applying the policy on failure.
+func (p Policy) IntToInt64(value int) (int64, error) {
+	return policyConvert(p, IntToInt64, value)
+}
+
+// IntToUint converts value as IntToUint does, applying the policy on failure.
+func (p Policy) IntToUint(value int) (uint, error) {
+	return policyConvert(p, IntToUint, value)
+}
+
+// IntToUint8 converts value as IntToUint8 does, applying the policy on failure.
+func (p Policy) IntToUint8(value int) (uint8, error) {
+	return policyConvert(p, IntToUint8, value)
+}
+
+// Int8ToInt converts value as Int8ToInt does, applying the policy on failure.
+func (p Policy) Int8ToInt(value int8) (int, error) {
+	return policyConvert(p, Int8ToInt, value)
+}
+
+// Int8ToInt16 converts value as Int8ToInt16 does, applying the policy on failure.
+func (p Policy) Int8ToInt16(value int8) (int16, error) {
+	return policyConvert(p, Int8ToInt16, value)
+}
+
+// Int8ToInt32 converts value as Int8ToInt32 does, applying the policy on failure.
+func (p Policy) Int8ToInt32(value int8) (int32, error) {
+	return policyConvert(p, Int8ToInt32, value)
+}
+
+// Int8ToInt64 converts value as Int8ToInt64 does, applying the policy on failure.
+func (p Policy) Int8ToInt64(value int8) (int64, error) {
+	return policyConvert(p, Int8ToInt64, value)
+}
+
+// Int8ToUint converts value as Int8ToUint does, applying the policy on failure.
+func (p Policy) Int8ToUint(value int8) (uint, error) {
+	return policyConvert(p, Int8ToUint, value)
+}
+
+// Int8ToUint8 converts value as Int8ToUint8 does, applying the policy on failure.
+func (p Policy) Int8ToUint8(value int8) (uint8, error) {
+	return policyConvert(p, Int8ToUint8, value)
+}
+
+// Int8ToUint16 converts value as Int8ToUint16 does, applying the policy on failure.
+func (p Policy) Int8ToUint16(value int8) (uint16, error) {
+	return policyConvert(p, Int8ToUint16, value)
+}
+
+// Int8ToUint32 converts value as Int8ToUint32 does, applying the policy on failure.
+func (p Policy) Int8ToUint32(value int8) (uint32, error) {
+	return policyConvert(p, Int8ToUint32, value)
+}
+
+// Int8ToUint64 converts value as Int8ToUint64 does, applying the policy on failure.
+func (p Policy) Int8ToUint64(value int8) (uint64, error) {
+	return policyConvert(p, Int8ToUint64, value)
+}
+
+// Int8ToUintptr converts value as Int8ToUintptr does, applying the policy on failure.
+func (p Policy) Int8ToUintptr(value int8) (uintptr, error) {
+	return policyConvert(p, Int8ToUintptr, value)
+}
+
+// Int16ToInt converts value as Int16ToInt does, applying the policy on failure.
+func (p Policy) Int16ToInt(value int16) (int, error) {
+	return policyConvert(p, Int16ToInt, value)
+}
+
+// Int16ToInt8 converts value as Int16ToInt8 does, applying the policy on failure.
+func (p Policy) Int16ToInt8(value int16) (int8, error) {
+	return policyConvert(p, Int16ToInt8, value)
+}
+
+// Int16ToInt32 converts value as Int16ToInt32 does, applying the policy on failure.
+func (p Policy) Int16ToInt32(value int16) (int32, error) {
+	return policyConvert(p, Int16ToInt32, value)
+}
+
+// Int16ToInt64 converts value as Int16ToInt64 does, applying the policy on failure.
+func (p Policy) Int16ToInt64(value int16) (int64, error) {
+	return policyConvert(p, Int16ToInt64, value)
+}
+
+// Int16ToUint converts value as Int16ToUint does, applying the policy on failure.
+func (p Policy) Int16ToUint(value int16) (uint, error) {
+	return policyConvert(p, Int16ToUint, value)
+}
+
+// Int16ToUint8 converts value as Int16ToUint8 does, applying the policy on failure.
+func (p Policy) Int16ToUint8(value int16) (uint8, error) {
+	return policyConvert(p, Int16ToUint8, value)
+}
+
+// Int16ToUint16 converts value as Int16ToUint16 does, applying the policy on failure.
+func (p Policy) Int16ToUint16(value int16) (uint16, error) {
+	return policyConvert(p, Int16ToUint16, value)
+}
+
+// Int16ToUint32 converts value as Int16ToUint32 does, applying the policy on failure.
+func (p Policy) Int16ToUint32(value int16) (uint32, error) {
+	return policyConvert(p, Int16ToUint32, value)
+}
+
+// Int16ToUint64 converts value as Int16ToUint64 does, applying the policy on failure.
+func (p Policy) Int16ToUint64(value int16) (uint64, error) {
+	return policyConvert(p, Int16ToUint64, value)
+}
+
+// Int16ToUintptr converts value as Int16ToUintptr does, applying the policy on failure.
+func (p Policy) Int16ToUintptr(value int16) (uintptr, error) {
+	return policyConvert(p, Int16ToUintptr, value)
+}
+
+// Int32ToInt converts value as Int32ToInt does, applying the policy on failure.
+func (p Policy) Int32ToInt(value int32) (int, error) {
+	return policyConvert(p, Int32ToInt, value)
+}
+
+// Int32ToInt8 converts value as Int32ToInt8 does, applying the policy on failure.
+func (p Policy) Int32ToInt8(value int32) (int8, error) {
+	return policyConvert(p, Int32ToInt8, value)
+}
+
+// Int32ToInt16 converts value as Int32ToInt16 does, applying the policy on failure.
+func (p Policy) Int32ToInt16(value int32) (int16, error) {
+	return policyConvert(p, Int32ToInt16, value)
+}
+
+// Int32ToInt64 converts value as Int32ToInt64 does, applying the policy on failure.
+func (p Policy) Int32ToInt64(value int32) (int64, error) {
+	return policyConvert(p, Int32ToInt64, value)
+}
+
+// Int32ToUint converts value as Int32ToUint does, applying the policy on failure.
+func (p Policy) Int32ToUint(value int32) (uint, error) {
+	return policyConvert(p, Int32ToUint, value)
+}
+
+// Int32ToUint8 converts value as Int32ToUint8 does, applying the policy on failure.
+func (p Policy) Int32ToUint8(value int32) (uint8, error) {
+	return policyConvert(p, Int32ToUint8, value)
+}
+
+// Int32ToUint16 converts value as Int32ToUint16 does, applying the policy on failure.
+func (p Policy) Int32ToUint16(value int32) (uint16, error) {
+	return policyConvert(p, Int32ToUint16, value)
+}
+
+// Int32ToUint64 converts value as Int32ToUint64 does, applying the policy on failure.
+func (p Policy) Int32ToUint64(value int32) (uint64, error) {
+	return policyConvert(p, Int32ToUint64, value)
+}
+
+// Int32ToUintptr converts value as Int32ToUintptr does, applying the policy on failure.
+func (p Policy) Int32ToUintptr(value int32) (uintptr, error) {
+	return policyConvert(p, Int32ToUintptr, value)
+}
+
+// Int64ToInt converts value as Int64ToInt does, applying the policy on failure.
+func (p Policy) Int64ToInt(value int64) (int, error) {
+	return policyConvert(p, Int64ToInt, value)
+}
+
+// Int64ToInt8 converts value as Int64ToInt8 does, applying the policy on failure.
+func (p Policy) Int64ToInt8(value int64) (int8, error) {
+	return policyConvert(p, Int64ToInt8, value)
+}
+
+// Int64ToInt16 converts value as Int64ToInt16 does, applying the policy on failure.
+func (p Policy) Int64ToInt16(value int64) (int16, error) {
+	return policyConvert(p, Int64ToInt16, value)
+}
+
+// Int64ToUint converts value as Int64ToUint does, applying the policy on failure.
+func (p Policy) Int64ToUint(value int64) (uint, error) {
+	return policyConvert(p, Int64ToUint, value)
+}
+
+// Int64ToUint8 converts value as Int64ToUint8 does, applying the policy on failure.
+func (p Policy) Int64ToUint8(value int64) (uint8, error) {
+	return policyConvert(p, Int64ToUint8, value)
+}
+
+// Int64ToUint16 converts value as Int64ToUint16 does, applying the policy on failure.
+func (p Policy) Int64ToUint16(value int64) (uint16, error) {
+	return policyConvert(p, Int64ToUint16, value)
+}
+
+// UintToInt converts value as UintToInt does, applying the policy on failure.
+func (p Policy) UintToInt(value uint) (int, error) {
+	return policyConvert(p, UintToInt, value)
+}
+
+// UintToInt8 converts value as UintToInt8 does, applying the policy on failure.
+func (p Policy) UintToInt8(value uint) (int8, error) {
+	return policyConvert(p, UintToInt8, value)
+}
+
+// UintToInt16 converts value as UintToInt16 does, applying the policy on failure.
+func (p Policy) UintToInt16(value uint) (int16, error) {
+	return policyConvert(p, UintToInt16, value)
+}
+
+// UintToInt32 converts value as UintToInt32 does, applying the policy on failure.
+func (p Policy) UintToInt32(value uint) (int32, error) {
+	return policyConvert(p, UintToInt32, value)
+}
+
+// UintToInt64 converts value as UintToInt64 does, applying the policy on failure.
+func (p Policy) UintToInt64(value uint) (int64, error) {
+	return policyConvert(p, UintToInt64, value)
+}
+
+// UintToUint8 converts value as UintToUint8 does, applying the policy on failure.
+func (p Policy) UintToUint8(value uint) (uint8, error) {
+	return policyConvert(p, UintToUint8, value)
+}
+
+// UintToUint16 converts value as UintToUint16 does, applying the policy on failure.
+func (p Policy) UintToUint16(value uint) (uint16, error) {
+	return policyConvert(p, UintToUint16, value)
+}
+
+// UintToUint64 converts value as UintToUint64 does, applying the policy on failure.
+func (p Policy) UintToUint64(value uint) (uint64, error) {
+	return policyConvert(p, UintToUint64, value)
+}
+
+// UintToUintptr converts value as UintToUintptr does, applying the policy on failure.
+func (p Policy) UintToUintptr(value uint) (uintptr, error) {
+	return policyConvert(p, UintToUintptr, value)
+}
+
+// Uint8ToInt converts value as Uint8ToInt does, applying the policy on failure.
+func (p Policy) Uint8ToInt(value uint8) (int, error) {
+	return policyConvert(p, Uint8ToInt, value)
+}
+
+// Uint8ToInt8 converts value as Uint8ToInt8 does, applying the policy on failure.
+func (p Policy) Uint8ToInt8(value uint8) (int8, error) {
+	return policyConvert(p, Uint8ToInt8, value)
+}
+
+// Uint8ToInt16 converts value as Uint8ToInt16 does, applying the policy on failure.
+func (p Policy) Uint8ToInt16(value uint8) (int16, error) {
+	return policyConvert(p, Uint8ToInt16, value)
+}
+
+// Uint8ToInt32 converts value as Uint8ToInt32 does, applying the policy on failure.
+func (p Policy) Uint8ToInt32(value uint8) (int32, error) {
+	return policyConvert(p, Uint8ToInt32, value)
+}
+
+// Uint8ToInt64 converts value as Uint8ToInt64 does, applying the policy on failure.
+func (p Policy) Uint8ToInt64(value uint8) (int64, error) {
+	return policyConvert(p, Uint8ToInt64, value)
+}
+
+// Uint8ToUint converts value as Uint8ToUint does, applying the policy on failure.
+func (p Policy) Uint8ToUint(value uint8) (uint, error) {
+	return policyConvert(p, Uint8ToUint, value)
+}
+
+// Uint8ToUint16 converts value as Uint8ToUint16 does, applying the policy on failure.
+func (p Policy) Uint8ToUint16(value uint8) (uint16, error) {
+	return policyConvert(p, Uint8ToUint16, value)
+}
+
+// Uint8ToUint32 converts value as Uint8ToUint32 does, applying the policy on failure.
+func (p Policy) Uint8ToUint32(value uint8) (uint32, error) {
+	return policyConvert(p, Uint8ToUint32, value)
+}
+
+// Uint8ToUint64 converts value as Uint8ToUint64 does, applying the policy on failure.
+func (p Policy) Uint8ToUint64(value uint8) (uint64, error) {
+	return policyConvert(p, Uint8ToUint64, value)
+}
+
+// Uint8ToUintptr converts value as Uint8ToUintptr does, applying the policy on failure.
+func (p Policy) Uint8ToUintptr(value uint8) (uintptr, error) {
+	return policyConvert(p, Uint8ToUintptr, value)
+}
+
+// Uint16ToInt converts value as Uint16ToInt does, applying the policy on failure.
+func (p Policy) Uint16ToInt(value uint16) (int, error) {
+	return policyConvert(p, Uint16ToInt, value)
+}
+
+// Uint16ToInt8 converts value as Uint16ToInt8 does, applying the policy on failure.
+func (p Policy) Uint16ToInt8(value uint16) (int8, error) {
+	return policyConvert(p, Uint16ToInt8, value)
+}
+
+// Uint16ToInt16 converts value as Uint16ToInt16 does, applying the policy on failure.
+func (p Policy) Uint16ToInt16(value uint16) (int16, error) {
+	return policyConvert(p, Uint16ToInt16, value)
+}
+
+// Uint16ToInt32 converts value as Uint16ToInt32 does, applying the policy on failure.
+func (p Policy) Uint16ToInt32(value uint16) (int32, error) {
+	return policyConvert(p, Uint16ToInt32, value)
+}
+
+// Uint16ToInt64 converts value as Uint16ToInt64 does, applying the policy on failure.
+func (p Policy) Uint16ToInt64(value uint16) (int64, error) {
+	return policyConvert(p, Uint16ToInt64, value)
+}
+
+// Uint16ToUint converts value as Uint16ToUint does, applying the policy on failure.
+func (p Policy) Uint16ToUint(value uint16) (uint, error) {
+	return policyConvert(p, Uint16ToUint, value)
+}
+
+// Uint16ToUint8 converts value as Uint16ToUint8 does, applying the policy on failure.
+func (p Policy) Uint16ToUint8(value uint16) (uint8, error) {
+	return policyConvert(p, Uint16ToUint8, value)
+}
+
+// Uint16ToUint32 converts value as Uint16ToUint32 does, applying the policy on failure.
+func (p Policy) Uint16ToUint32(value uint16) (uint32, error) {
+	return policyConvert(p, Uint16ToUint32, value)
+}
+
+// Uint16ToUint64 converts value as Uint16ToUint64 does, applying the policy on failure.
+func (p Policy) Uint16ToUint64(value uint16) (uint64, error) {
+	return policyConvert(p, Uint16ToUint64, value)
+}
+
+// Uint16ToUintptr converts value as Uint16ToUintptr does, applying the policy on failure.
+func (p Policy) Uint16ToUintptr(value uint16) (uintptr, error) {
+	return policyConvert(p, Uint16ToUintptr, value)
+}
+
+// Uint32ToInt converts value as Uint32ToInt does, applying the policy on failure.
+func (p Policy) Uint32ToInt(value uint32) (int, error) {
+	return policyConvert(p, Uint32ToInt, value)
+}
+
+// Uint32ToInt8 converts value as Uint32ToInt8 does, applying the policy on failure.
+func (p Policy) Uint32ToInt8(value uint32) (int8, error) {
+	return policyConvert(p, Uint32ToInt8, value)
+}
+
+// Uint32ToInt16 converts value as Uint32ToInt16 does, applying the policy on failure.
+func (p Policy) Uint32ToInt16(value uint32) (int16, error) {
+	return policyConvert(p, Uint32ToInt16, value)
+}
+
+// Uint32ToUint converts value as Uint32ToUint does, applying the policy on failure.
+func (p Policy) Uint32ToUint(value uint32) (uint, error) {
+	return policyConvert(p, Uint32ToUint, value)
+}
+
+// Uint32ToUint16 converts value as Uint32ToUint16 does, applying the policy on failure.
+func (p Policy) Uint32ToUint16(value uint32) (uint16, error) {
+	return policyConvert(p, Uint32ToUint16, value)
+}
+
+// Uint32ToUintptr converts value as Uint32ToUintptr does, applying the policy on failure.
+func (p Policy) Uint32ToUintptr(value uint32) (uintptr, error) {
+	return policyConvert(p, Uint32ToUintptr, value)
+}
+
+// Uint64ToInt8 converts value as Uint64ToInt8 does, applying the policy on failure.
+func (p Policy) Uint64ToInt8(value uint64) (int8, error) {
+	return policyConvert(p, Uint64ToInt8, value)
+}
+
+// Uint64ToInt16 converts value as Uint64ToInt16 does, applying the policy on failure.
+func (p Policy) Uint64ToInt16(value uint64) (int16, error) {
+	return policyConvert(p, Uint64ToInt16, value)
+}
+
+// Uint64ToUint converts value as Uint64ToUint does, applying the policy on failure.
+func (p Policy) Uint64ToUint(value uint64) (uint, error) {
+	return policyConvert(p, Uint64ToUint, value)
+}
+
+// Uint64ToUint8 converts value as Uint64ToUint8 does, applying the policy on failure.
+func (p Policy) Uint64ToUint8(value uint64) (uint8, error) {
+	return policyConvert(p, Uint64ToUint8, value)
+}
+
+// UintptrToInt8 converts value as UintptrToInt8 does, applying the policy on failure.
+func (p Policy) UintptrToInt8(value uintptr) (int8, error) {
+	return policyConvert(p, UintptrToInt8, value)
+}
+
+// UintptrToInt16 converts value as UintptrToInt16 does, applying the policy on failure.
+func (p Policy) UintptrToInt16(value uintptr) (int16, error) {
+	return policyConvert(p, UintptrToInt16, value)
+}
+
+// UintptrToInt32 converts value as UintptrToInt32 does, applying the policy on failure.
+func (p Policy) UintptrToInt32(value uintptr) (int32, error) {
+	return policyConvert(p, UintptrToInt32, value)
+}
+
+// UintptrToInt64 converts value as UintptrToInt64 does, applying the policy on failure.
+func (p Policy) UintptrToInt64(value uintptr) (int64, error) {
+	return policyConvert(p, UintptrToInt64, value)
+}
+
+// UintptrToUint converts value as UintptrToUint does, applying the policy on failure.
+func (p Policy) UintptrToUint(value uintptr) (uint, error) {
+	return policyConvert(p, UintptrToUint, value)
+}
+
+// UintptrToUint8 converts value as UintptrToUint8 does, applying the policy on failure.
+func (p Policy) UintptrToUint8(value uintptr) (uint8, error) {
+	return policyConvert(p, UintptrToUint8, value)
+}
+
+// UintptrToUint16 converts value as UintptrToUint16 does, applying the policy on failure.
+func (p Policy) UintptrToUint16(value uintptr) (uint16, error) {
+	return policyConvert(p, UintptrToUint16, value)
+}
+
+// UintptrToUint32 converts value as UintptrToUint32 does, applying the policy on failure.
+func (p Policy) UintptrToUint32(value uintptr) (uint32, error) {
+	return policyConvert(p, UintptrToUint32, value)
+}
+
+// UintptrToUint64 converts value as UintptrToUint64 does, applying the policy on failure.
+func (p Policy) UintptrToUint64(value uintptr) (uint64, error) {
+	return policyConvert(p, UintptrToUint64, value)
+}
@@ -0,0 +1,962 @@
+// Code generated by gensafeconv. DO NOT EDIT.
+
+package safeconversion
+
+import "fmt"
+
+// ExampleIntToInt8 demonstrates converting an int to an int8.
+func ExampleIntToInt8() {
+	v, err := IntToInt8(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleIntToInt64 demonstrates converting an int to an int64.
+func ExampleIntToInt64() {
+	v, err := IntToInt64(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleIntToUint demonstrates converting an int to an uint.
+func ExampleIntToUint() {
+	v, err := IntToUint(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleIntToUint8 demonstrates converting an int to an uint8.
+func ExampleIntToUint8() {
+	v, err := IntToUint8(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleInt8ToInt demonstrates converting an int8 to an int.
+func ExampleInt8ToInt() {
+	v, err := Int8ToInt(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleInt8ToInt16 demonstrates converting an int8 to an int16.
+func ExampleInt8ToInt16() {
+	v, err := Int8ToInt16(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleInt8ToInt32 demonstrates converting an int8 to an int32.
+func ExampleInt8ToInt32() {
+	v, err := Int8ToInt32(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleInt8ToInt64 demonstrates converting an int8 to an int64.
+func ExampleInt8ToInt64() {
+	v, err := Int8ToInt64(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleInt8ToUint demonstrates converting an int8 to an uint.
+func ExampleInt8ToUint() {
+	v, err := Int8ToUint(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleInt8ToUint8 demonstrates converting an int8 to an uint8.
+func ExampleInt8ToUint8() {
+	v, err := Int8ToUint8(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleInt8ToUint16 demonstrates converting an int8 to an uint16.
+func ExampleInt8ToUint16() {
+	v, err := Int8ToUint16(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleInt8ToUint32 demonstrates converting an int8 to an uint32.
+func ExampleInt8ToUint32() {
+	v, err := Int8ToUint32(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleInt8ToUint64 demonstrates converting an int8 to an uint64.
+func ExampleInt8ToUint64() {
+	v, err := Int8ToUint64(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleInt8ToUintptr demonstrates converting an int8 to an uintptr.
+func ExampleInt8ToUintptr() {
+	v, err := Int8ToUintptr(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleInt16ToInt demonstrates converting an int16 to an int.
+func ExampleInt16ToInt() {
+	v, err := Int16ToInt(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleInt16ToInt8 demonstrates converting an int16 to an int8.
+func ExampleInt16ToInt8() {
+	v, err := Int16ToInt8(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleInt16ToInt32 demonstrates converting an int16 to an int32.
+func ExampleInt16ToInt32() {
+	v, err := Int16ToInt32(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleInt16ToInt64 demonstrates converting an int16 to an int64.
+func ExampleInt16ToInt64() {
+	v, err := Int16ToInt64(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleInt16ToUint demonstrates converting an int16 to an uint.
+func ExampleInt16ToUint() {
+	v, err := Int16ToUint(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleInt16ToUint8 demonstrates converting an int16 to an uint8.
+func ExampleInt16ToUint8() {
+	v, err := Int16ToUint8(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleInt16ToUint16 demonstrates converting an int16 to an uint16.
+func ExampleInt16ToUint16() {
+	v, err := Int16ToUint16(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleInt16ToUint32 demonstrates converting an int16 to an uint32.
+func ExampleInt16ToUint32() {
+	v, err := Int16ToUint32(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleInt16ToUint64 demonstrates converting an int16 to an uint64.
+func ExampleInt16ToUint64() {
+	v, err := Int16ToUint64(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleInt16ToUintptr demonstrates converting an int16 to an uintptr.
+func ExampleInt16ToUintptr() {
+	v, err := Int16ToUintptr(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleInt32ToInt demonstrates converting an int32 to an int.
+func ExampleInt32ToInt() {
+	v, err := Int32ToInt(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleInt32ToInt8 demonstrates converting an int32 to an int8.
+func ExampleInt32ToInt8() {
+	v, err := Int32ToInt8(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleInt32ToInt16 demonstrates converting an int32 to an int16.
+func ExampleInt32ToInt16() {
+	v, err := Int32ToInt16(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleInt32ToInt64 demonstrates converting an int32 to an int64.
+func ExampleInt32ToInt64() {
+	v, err := Int32ToInt64(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleInt32ToUint demonstrates converting an int32 to an uint.
+func ExampleInt32ToUint() {
+	v, err := Int32ToUint(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleInt32ToUint8 demonstrates converting an int32 to an uint8.
+func ExampleInt32ToUint8() {
+	v, err := Int32ToUint8(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleInt32ToUint16 demonstrates converting an int32 to an uint16.
+func ExampleInt32ToUint16() {
+	v, err := Int32ToUint16(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleInt32ToUint64 demonstrates converting an int32 to an uint64.
+func ExampleInt32ToUint64() {
+	v, err := Int32ToUint64(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleInt32ToUintptr demonstrates converting an int32 to an uintptr.
+func ExampleInt32ToUintptr() {
+	v, err := Int32ToUintptr(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleInt64ToInt demonstrates converting an int64 to an int.
+func ExampleInt64ToInt() {
+	v, err := Int64ToInt(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleInt64ToInt8 demonstrates converting an int64 to an int8.
+func ExampleInt64ToInt8() {
+	v, err := Int64ToInt8(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleInt64ToInt16 demonstrates converting an int64 to an int16.
+func ExampleInt64ToInt16() {
+	v, err := Int64ToInt16(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleInt64ToUint demonstrates converting an int64 to an uint.
+func ExampleInt64ToUint() {
+	v, err := Int64ToUint(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleInt64ToUint8 demonstrates converting an int64 to an uint8.
+func ExampleInt64ToUint8() {
+	v, err := Int64ToUint8(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleInt64ToUint16 demonstrates converting an int64 to an uint16.
+func ExampleInt64ToUint16() {
+	v, err := Int64ToUint16(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleUintToInt demonstrates converting an uint to an int.
+func ExampleUintToInt() {
+	v, err := UintToInt(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleUintToInt8 demonstrates converting an uint to an int8.
+func ExampleUintToInt8() {
+	v, err := UintToInt8(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleUintToInt16 demonstrates converting an uint to an int16.
+func ExampleUintToInt16() {
+	v, err := UintToInt16(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleUintToInt32 demonstrates converting an uint to an int32.
+func ExampleUintToInt32() {
+	v, err := UintToInt32(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleUintToInt64 demonstrates converting an uint to an int64.
+func ExampleUintToInt64() {
+	v, err := UintToInt64(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleUintToUint8 demonstrates converting an uint to an uint8.
+func ExampleUintToUint8() {
+	v, err := UintToUint8(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleUintToUint16 demonstrates converting an uint to an uint16.
+func ExampleUintToUint16() {
+	v, err := UintToUint16(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleUintToUint64 demonstrates converting an uint to an uint64.
+func ExampleUintToUint64() {
+	v, err := UintToUint64(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleUintToUintptr demonstrates converting an uint to an uintptr.
+func ExampleUintToUintptr() {
+	v, err := UintToUintptr(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleUint8ToInt demonstrates converting an uint8 to an int.
+func ExampleUint8ToInt() {
+	v, err := Uint8ToInt(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleUint8ToInt8 demonstrates converting an uint8 to an int8.
+func ExampleUint8ToInt8() {
+	v, err := Uint8ToInt8(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleUint8ToInt16 demonstrates converting an uint8 to an int16.
+func ExampleUint8ToInt16() {
+	v, err := Uint8ToInt16(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleUint8ToInt32 demonstrates converting an uint8 to an int32.
+func ExampleUint8ToInt32() {
+	v, err := Uint8ToInt32(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleUint8ToInt64 demonstrates converting an uint8 to an int64.
+func ExampleUint8ToInt64() {
+	v, err := Uint8ToInt64(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleUint8ToUint demonstrates converting an uint8 to an uint.
+func ExampleUint8ToUint() {
+	v, err := Uint8ToUint(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleUint8ToUint16 demonstrates converting an uint8 to an uint16.
+func ExampleUint8ToUint16() {
+	v, err := Uint8ToUint16(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleUint8ToUint32 demonstrates converting an uint8 to an uint32.
+func ExampleUint8ToUint32() {
+	v, err := Uint8ToUint32(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleUint8ToUint64 demonstrates converting an uint8 to an uint64.
+func ExampleUint8ToUint64() {
+	v, err := Uint8ToUint64(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleUint8ToUintptr demonstrates converting an uint8 to an uintptr.
+func ExampleUint8ToUintptr() {
+	v, err := Uint8ToUintptr(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleUint16ToInt demonstrates converting an uint16 to an int.
+func ExampleUint16ToInt() {
+	v, err := Uint16ToInt(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleUint16ToInt8 demonstrates converting an uint16 to an int8.
+func ExampleUint16ToInt8() {
+	v, err := Uint16ToInt8(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleUint16ToInt16 demonstrates converting an uint16 to an int16.
+func ExampleUint16ToInt16() {
+	v, err := Uint16ToInt16(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleUint16ToInt32 demonstrates converting an uint16 to an int32.
+func ExampleUint16ToInt32() {
+	v, err := Uint16ToInt32(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleUint16ToInt64 demonstrates converting an uint16 to an int64.
+func ExampleUint16ToInt64() {
+	v, err := Uint16ToInt64(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleUint16ToUint demonstrates converting an uint16 to an uint.
+func ExampleUint16ToUint() {
+	v, err := Uint16ToUint(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleUint16ToUint8 demonstrates converting an uint16 to an uint8.
+func ExampleUint16ToUint8() {
+	v, err := Uint16ToUint8(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleUint16ToUint32 demonstrates converting an uint16 to an uint32.
+func ExampleUint16ToUint32() {
+	v, err := Uint16ToUint32(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleUint16ToUint64 demonstrates converting an uint16 to an uint64.
+func ExampleUint16ToUint64() {
+	v, err := Uint16ToUint64(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleUint16ToUintptr demonstrates converting an uint16 to an uintptr.
+func ExampleUint16ToUintptr() {
+	v, err := Uint16ToUintptr(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleUint32ToInt demonstrates converting an uint32 to an int.
+func ExampleUint32ToInt() {
+	v, err := Uint32ToInt(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleUint32ToInt8 demonstrates converting an uint32 to an int8.
+func ExampleUint32ToInt8() {
+	v, err := Uint32ToInt8(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleUint32ToInt16 demonstrates converting an uint32 to an int16.
+func ExampleUint32ToInt16() {
+	v, err := Uint32ToInt16(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleUint32ToUint demonstrates converting an uint32 to an uint.
+func ExampleUint32ToUint() {
+	v, err := Uint32ToUint(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleUint32ToUint16 demonstrates converting an uint32 to an uint16.
+func ExampleUint32ToUint16() {
+	v, err := Uint32ToUint16(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleUint32ToUintptr demonstrates converting an uint32 to an uintptr.
+func ExampleUint32ToUintptr() {
+	v, err := Uint32ToUintptr(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleUint64ToInt8 demonstrates converting an uint64 to an int8.
+func ExampleUint64ToInt8() {
+	v, err := Uint64ToInt8(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleUint64ToInt16 demonstrates converting an uint64 to an int16.
+func ExampleUint64ToInt16() {
+	v, err := Uint64ToInt16(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleUint64ToUint demonstrates converting an uint64 to an uint.
+func ExampleUint64ToUint() {
+	v, err := Uint64ToUint(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleUint64ToUint8 demonstrates converting an uint64 to an uint8.
+func ExampleUint64ToUint8() {
+	v, err := Uint64ToUint8(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleUintptrToInt8 demonstrates converting an uintptr to an int8.
+func ExampleUintptrToInt8() {
+	v, err := UintptrToInt8(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleUintptrToInt16 demonstrates converting an uintptr to an int16.
+func ExampleUintptrToInt16() {
+	v, err := UintptrToInt16(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleUintptrToInt32 demonstrates converting an uintptr to an int32.
+func ExampleUintptrToInt32() {
+	v, err := UintptrToInt32(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleUintptrToInt64 demonstrates converting an uintptr to an int64.
+func ExampleUintptrToInt64() {
+	v, err := UintptrToInt64(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleUintptrToUint demonstrates converting an uintptr to an uint.
+func ExampleUintptrToUint() {
+	v, err := UintptrToUint(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleUintptrToUint8 demonstrates converting an uintptr to an uint8.
+func ExampleUintptrToUint8() {
+	v, err := UintptrToUint8(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleUintptrToUint16 demonstrates converting an uintptr to an uint16.
+func ExampleUintptrToUint16() {
+	v, err := UintptrToUint16(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleUintptrToUint32 demonstrates converting an uintptr to an uint32.
+func ExampleUintptrToUint32() {
+	v, err := UintptrToUint32(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleUintptrToUint64 demonstrates converting an uintptr to an uint64.
+func ExampleUintptrToUint64() {
+	v, err := UintptrToUint64(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
@@ -0,0 +1,3122 @@
+// Code generated by gensafeconv. DO NOT EDIT.
+
+package safeconversion_test
+
+import (
+	"math"
+	"testing"
+
+	safe "github.com/bsv-blockchain/go-safe-conversion"
+)
+
+// TestIntToInt8 tests the conversion from int to int8 at the boundaries of both types.
+func TestIntToInt8(t *testing.T) {
+	for _, v := range []int{math.MinInt, math.MinInt + 1, -1, 0, 1, math.MaxInt - 1, math.MaxInt, math.MinInt8 - 1, math.MinInt8, math.MaxInt8, math.MaxInt8 + 1} {
+		checkConversion(t, safe.IntToInt8, v)
+	}
+}
+
+// TestIntToInt64 tests the conversion from int to int64 at the boundaries of both types.
+func TestIntToInt64(t *testing.T) {
+	for _, v := range []int{math.MinInt, math.MinInt + 1, -1, 0, 1, math.MaxInt - 1, math.MaxInt} {
+		checkConversion(t, safe.IntToInt64, v)
+	}
+}
+
+// TestIntToUint tests the conversion from int to uint at the boundaries of both types.
+func TestIntToUint(t *testing.T) {
+	for _, v := range []int{math.MinInt, math.MinInt + 1, -1, 0, 1, math.MaxInt - 1, math.MaxInt} {
+		checkConversion(t, safe.IntToUint, v)
+	}
+}
+
+// TestIntToUint8 tests the conversion from int to uint8 at the boundaries of both types.
+func TestIntToUint8(t *testing.T) {
+	for _, v := range []int{math.MinInt, math.MinInt + 1, -1, 0, 1, math.MaxInt - 1, math.MaxInt, math.MaxUint8, math.MaxUint8 + 1} {
+		checkConversion(t, safe.IntToUint8, v)
+	}
+}
+
+// TestInt8ToInt tests the conversion from int8 to int at the boundaries of both types.
+func TestInt8ToInt(t *testing.T) {
+	for _, v := range []int8{math.MinInt8, math.MinInt8 + 1, -1, 0, 1, math.MaxInt8 - 1, math.MaxInt8} {
+		checkConversion(t, safe.Int8ToInt, v)
+	}
+}
+
+// TestInt8ToInt16 tests the conversion from int8 to int16 at the boundaries of both types.
+func TestInt8ToInt16(t *testing.T) {
+	for _, v := range []int8{math.MinInt8, math.MinInt8 + 1, -1, 0, 1, math.MaxInt8 - 1, math.MaxInt8} {
+		checkConversion(t, safe.Int8ToInt16, v)
+	}
+}
+
+// TestInt8ToInt32 tests the conversion from int8 to int32 at the boundaries of both types.
+func TestInt8ToInt32(t *testing.T) {
+	for _, v := range []int8{math.MinInt8, math.MinInt8 + 1, -1, 0, 1, math.MaxInt8 - 1, math.MaxInt8} {
+		checkConversion(t, safe.Int8ToInt32, v)
+	}
+}
+
+// TestInt8ToInt64 tests the conversion from int8 to int64 at the boundaries of both types.
+func TestInt8ToInt64(t *testing.T) {
+	for _, v := range []int8{math.MinInt8, math.MinInt8 + 1, -1, 0, 1, math.MaxInt8 - 1, math.MaxInt8} {
+		checkConversion(t, safe.Int8ToInt64, v)
+	}
+}
+
+// TestInt8ToUint tests the conversion from int8 to uint at the boundaries of both types.
+func TestInt8ToUint(t *testing.T) {
+	for _, v := range []int8{math.MinInt8, math.MinInt8 + 1, -1, 0, 1, math.MaxInt8 - 1, math.MaxInt8} {
+		checkConversion(t, safe.Int8ToUint, v)
+	}
+}
+
+// TestInt8ToUint8 tests the conversion from int8 to uint8 at the boundaries of both types.
+func TestInt8ToUint8(t *testing.T) {
+	for _, v := range []int8{math.MinInt8, math.MinInt8 + 1, -1, 0, 1, math.MaxInt8 - 1, math.MaxInt8} {
+		checkConversion(t, safe.Int8ToUint8, v)
+	}
+}
+
+// TestInt8ToUint16 tests the conversion from int8 to uint16 at the boundaries of both types.
+func TestInt8ToUint16(t *testing.T) {
+	for _, v := range []int8{math.MinInt8, math.MinInt8 + 1, -1, 0, 1, math.MaxInt8 - 1, math.MaxInt8} {
+		checkConversion(t, safe.Int8ToUint16, v)
+	}
+}
+
+// TestInt8ToUint32 tests the conversion from int8 to uint32 at the boundaries of both types.
+func TestInt8ToUint32(t *testing.T) {
+	for _, v := range []int8{math.MinInt8, math.MinInt8 + 1, -1, 0, 1, math.MaxInt8 - 1, math.MaxInt8} {
+		checkConversion(t, safe.Int8ToUint32, v)
+	}
+}
+
+// TestInt8ToUint64 tests the conversion from int8 to uint64 at the boundaries of both types.
+func TestInt8ToUint64(t *testing.T) {
+	for _, v := range []int8{math.MinInt8, math.MinInt8 + 1, -1, 0, 1, math.MaxInt8 - 1, math.MaxInt8} {
+		checkConversion(t, safe.Int8ToUint64, v)
+	}
+}
+
+// TestInt8ToUintptr tests the conversion from int8 to uintptr at the boundaries of both types.
+func TestInt8ToUintptr(t *testing.T) {
+	for _, v := range []int8{math.MinInt8, math.MinInt8 + 1, -1, 0, 1, math.MaxInt8 - 1, math.MaxInt8} {
+		checkConversion(t, safe.Int8ToUintptr, v)
+	}
+}
+
+// TestInt16ToInt tests the conversion from int16 to int at the boundaries of both types.
+func TestInt16ToInt(t *testing.T) {
+	for _, v := range []int16{math.MinInt16, math.MinInt16 + 1, -1, 0, 1, math.MaxInt16 - 1, math.MaxInt16} {
+		checkConversion(t, safe.Int16ToInt, v)
+	}
+}
+
+// TestInt16ToInt8 tests the conversion from int16 to int8 at the boundaries of both types.
+func TestInt16ToInt8(t *testing.T) {
+	for _, v := range []int16{math.MinInt16, math.MinInt16 + 1, -1, 0, 1, math.MaxInt16 - 1, math.MaxInt16, math.MinInt8 - 1, math.MinInt8, math.MaxInt8, math.MaxInt8 + 1} {
+		checkConversion(t, safe.Int16ToInt8, v)
+	}
+}
+
+// TestInt16ToInt32 tests the conversion from int16 to int32 at the boundaries of both types.
+func TestInt16ToInt32(t *testing.T) {
+	for _, v := range []int16{math.MinInt16, math.MinInt16 + 1, -1, 0, 1, math.MaxInt16 - 1, math.MaxInt16} {
+		checkConversion(t, safe.Int16ToInt32, v)
+	}
+}
+
+// TestInt16ToInt64 tests the conversion from int16 to int64 at the boundaries of both types.
+func TestInt16ToInt64(t *testing.T) {
+	for _, v := range []int16{math.MinInt16, math.MinInt16 + 1, -1, 0, 1, math.MaxInt16 - 1, math.MaxInt16} {
+		checkConversion(t, safe.Int16ToInt64, v)
+	}
+}
+
+// TestInt16ToUint tests the conversion from int16 to uint at the boundaries of both types.
+func TestInt16ToUint(t *testing.T) {
+	for _, v := range []int16{math.MinInt16, math.MinInt16 + 1, -1, 0, 1, math.MaxInt16 - 1, math.MaxInt16} {
+		checkConversion(t, safe.Int16ToUint, v)
+	}
+}
+
+// TestInt16ToUint8 tests the conversion from int16 to uint8 at the boundaries of both types.
+func TestInt16ToUint8(t *testing.T) {
+	for _, v := range []int16{math.MinInt16, math.MinInt16 + 1, -1, 0, 1, math.MaxInt16 - 1, math.MaxInt16, math.MaxUint8, math.MaxUint8 + 1} {
+		checkConversion(t, safe.Int16ToUint8, v)
+	}
+}
+
+// TestInt16ToUint16 tests the conversion from int16 to uint16 at the boundaries of both types.
+func TestInt16ToUint16(t *testing.T) {
+	for _, v := range []int16{math.MinInt16, math.MinInt16 + 1, -1, 0, 1, math.MaxInt16 - 1, math.MaxInt16} {
+		checkConversion(t, safe.Int16ToUint16, v)
+	}
+}
+
+// TestInt16ToUint32 tests the conversion from int16 to uint32 at the boundaries of both types.
+func TestInt16ToUint32(t *testing.T) {
+	for _, v := range []int16{math.MinInt16, math.MinInt16 + 1, -1, 0, 1, math.MaxInt16 - 1, math.MaxInt16} {
+		checkConversion(t, safe.Int16ToUint32, v)
+	}
+}
+
+// TestInt16ToUint64 tests the conversion from int16 to uint64 at the boundaries of both types.
+func TestInt16ToUint64(t *testing.T) {
+	for _, v := range []int16{math.MinInt16, math.MinInt16 + 1, -1, 0, 1, math.MaxInt16 - 1, math.MaxInt16} {
+		checkConversion(t, safe.Int16ToUint64, v)
+	}
+}
+
+// TestInt16ToUintptr tests the conversion from int16 to uintptr at the boundaries of both types.
+func TestInt16ToUintptr(t *testing.T) {
+	for _, v := range []int16{math.MinInt16, math.MinInt16 + 1, -1, 0, 1, math.MaxInt16 - 1, math.MaxInt16} {
+		checkConversion(t, safe.Int16ToUintptr, v)
+	}
+}
+
+// TestInt32ToInt tests the conversion from int32 to int at the boundaries of both types.
+func TestInt32ToInt(t *testing.T) {
+	for _, v := range []int32{math.MinInt32, math.MinInt32 + 1, -1, 0, 1, math.MaxInt32 - 1, math.MaxInt32} {
+		checkConversion(t, safe.Int32ToInt, v)
+	}
+}
+
+// TestInt32ToInt8 tests the conversion from int32 to int8 at the boundaries of both types.
+func TestInt32ToInt8(t *testing.T) {
+	for _, v := range []int32{math.MinInt32, math.MinInt32 + 1, -1, 0, 1, math.MaxInt32 - 1, math.MaxInt32, math.MinInt8 - 1, math.MinInt8, math.MaxInt8, math.MaxInt8 + 1} {
+		checkConversion(t, safe.Int32ToInt8, v)
+	}
+}
+
+// TestInt32ToInt16 tests the conversion from int32 to int16 at the boundaries of both types.
+func TestInt32ToInt16(t *testing.T) {
+	for _, v := range []int32{math.MinInt32, math.MinInt32 + 1, -1, 0, 1, math.MaxInt32 - 1, math.MaxInt32, math.MinInt16 - 1, math.MinInt16, math.MaxInt16, math.MaxInt16 + 1} {
+		checkConversion(t, safe.Int32ToInt16, v)
+	}
+}
+
+// TestInt32ToInt64 tests the conversion from int32 to int64 at the boundaries of both types.
+func TestInt32ToInt64(t *testing.T) {
+	for _, v := range []int32{math.MinInt32, math.MinInt32 + 1, -1, 0, 1, math.MaxInt32 - 1, math.MaxInt32} {
+		checkConversion(t, safe.Int32ToInt64, v)
+	}
+}
+
+// TestInt32ToUint tests the conversion from int32 to uint at the boundaries of both types.
+func TestInt32ToUint(t *testing.T) {
+	for _, v := range []int32{math.MinInt32, math.MinInt32 + 1, -1, 0, 1, math.MaxInt32 - 1, math.MaxInt32} {
+		checkConversion(t, safe.Int32ToUint, v)
+	}
+}
+
+// TestInt32ToUint8 tests the conversion from int32 to uint8 at the boundaries of both types.
+func TestInt32ToUint8(t *testing.T) {
+	for _, v := range []int32{math.MinInt32, math.MinInt32 + 1, -1, 0, 1, math.MaxInt32 - 1, math.MaxInt32, math.MaxUint8, math.MaxUint8 + 1} {
+		checkConversion(t, safe.Int32ToUint8, v)
+	}
+}
+
+// TestInt32ToUint16 tests the conversion from int32 to uint16 at the boundaries of both types.
+func TestInt32ToUint16(t *testing.T) {
+	for _, v := range []int32{math.MinInt32, math.MinInt32 + 1, -1, 0, 1, math.MaxInt32 - 1, math.MaxInt32, math.MaxUint16, math.MaxUint16 + 1} {
+		checkConversion(t, safe.Int32ToUint16, v)
+	}
+}
+
+// TestInt32ToUint64 tests the conversion from int32 to uint64 at the boundaries of both types.
+func TestInt32ToUint64(t *testing.T) {
+	for _, v := range []int32{math.MinInt32, math.MinInt32 + 1, -1, 0, 1, math.MaxInt32 - 1, math.MaxInt32} {
+		checkConversion(t, safe.Int32ToUint64, v)
+	}
+}
+
+// TestInt32ToUintptr tests the conversion from int32 to uintptr at the boundaries of both types.
+func TestInt32ToUintptr(t *testing.T) {
+	for _, v := range []int32{math.MinInt32, math.MinInt32 + 1, -1, 0, 1, math.MaxInt32 - 1, math.MaxInt32} {
+		checkConversion(t, safe.Int32ToUintptr, v)
+	}
+}
+
+// TestInt64ToInt tests the conversion from int64 to int at the boundaries of both types.
+func TestInt64ToInt(t *testing.T) {
+	for _, v := range []int64{math.MinInt64, math.MinInt64 + 1, -1, 0, 1, math.MaxInt64 - 1, math.MaxInt64} {
+		checkConversion(t, safe.Int64ToInt, v)
+	}
+}
+
+// TestInt64ToInt8 tests the conversion from int64 to int8 at the boundaries of both types.
+func TestInt64ToInt8(t *testing.T) {
+	for _, v := range []int64{math.MinInt64, math.MinInt64 + 1, -1, 0, 1, math.MaxInt64 - 1, math.MaxInt64, math.MinInt8 - 1, math.MinInt8, math.MaxInt8, math.MaxInt8 + 1} {
+		checkConversion(t, safe.Int64ToInt8, v)
+	}
+}
+
+// TestInt64ToInt16 tests the conversion from int64 to int16 at the boundaries of both types.
+func TestInt64ToInt16(t *testing.T) {
+	for _, v := range []int64{math.MinInt64, math.MinInt64 + 1, -1, 0, 1, math.MaxInt64 - 1, math.MaxInt64, math.MinInt16 - 1, math.MinInt16, math.MaxInt16, math.MaxInt16 + 1} {
+		checkConversion(t, safe.Int64ToInt16, v)
+	}
+}
+
+// TestInt64ToUint tests the conversion from int64 to uint at the boundaries of both types.
+func TestInt64ToUint(t *testing.T) {
+	for _, v := range []int64{math.MinInt64, math.MinInt64 + 1, -1, 0, 1, math.MaxInt64 - 1, math.MaxInt64} {
+		checkConversion(t, safe.Int64ToUint, v)
+	}
+}
+
+// TestInt64ToUint8 tests the conversion from int64 to uint8 at the boundaries of both types.
+func TestInt64ToUint8(t *testing.T) {
+	for _, v := range []int64{math.MinInt64, math.MinInt64 + 1, -1, 0, 1, math.MaxInt64 - 1, math.MaxInt64, math.MaxUint8, math.MaxUint8 + 1} {
+		checkConversion(t, safe.Int64ToUint8, v)
+	}
+}
+
+// TestInt64ToUint16 tests the conversion from int64 to uint16 at the boundaries of both types.
+func TestInt64ToUint16(t *testing.T) {
+	for _, v := range []int64{math.MinInt64, math.MinInt64 + 1, -1, 0, 1, math.MaxInt64 - 1, math.MaxInt64, math.MaxUint16, math.MaxUint16 + 1} {
+		checkConversion(t, safe.Int64ToUint16, v)
+	}
+}
+
+// TestUintToInt tests the conversion from uint to int at the boundaries of both types.
+func TestUintToInt(t *testing.T) {
+	for _, v := range []uint{0, 1, math.MaxUint, math.MaxInt, math.MaxInt + 1} {
+		checkConversion(t, safe.UintToInt, v)
+	}
+}
+
+// TestUintToInt8 tests the conversion from uint to int8 at the boundaries of both types.
+func TestUintToInt8(t *testing.T) {
+	for _, v := range []uint{0, 1, math.MaxUint, math.MaxInt8, math.MaxInt8 + 1} {
+		checkConversion(t, safe.UintToInt8, v)
+	}
+}
+
+// TestUintToInt16 tests the conversion from uint to int16 at the boundaries of both types.
+func TestUintToInt16(t *testing.T) {
+	for _, v := range []uint{0, 1, math.MaxUint, math.MaxInt16, math.MaxInt16 + 1} {
+		checkConversion(t, safe.UintToInt16, v)
+	}
+}
+
+// TestUintToInt32 tests the conversion from uint to int32 at the boundaries of both types.
+func TestUintToInt32(t *testing.T) {
+	for _, v := range []uint{0, 1, math.MaxUint, math.MaxInt32, math.MaxInt32 + 1} {
+		checkConversion(t, safe.UintToInt32, v)
+	}
+}
+
+// TestUintToInt64 tests the conversion from uint to int64 at the boundaries of both types.
+func TestUintToInt64(t *testing.T) {
+	for _, v := range []uint{0, 1, math.MaxUint} {
+		checkConversion(t, safe.UintToInt64, v)
+	}
+}
+
+// TestUintToUint8 tests the conversion from uint to uint8 at the boundaries of both types.
+func TestUintToUint8(t *testing.T) {
+	for _, v := range []uint{0, 1, math.MaxUint, math.MaxUint8, math.MaxUint8 + 1} {
+		checkConversion(t, safe.UintToUint8, v)
+	}
+}
+
+// TestUintToUint16 tests the conversion from uint to uint16 at the boundaries of both types.
+func TestUintToUint16(t *testing.T) {
+	for _, v := range []uint{0, 1, math.MaxUint, math.MaxUint16, math.MaxUint16 + 1} {
+		checkConversion(t, safe.UintToUint16, v)
+	}
+}
+
+// TestUintToUint64 tests the conversion from uint to uint64 at the boundaries of both types.
+func TestUintToUint64(t *testing.T) {
+	for _, v := range []uint{0, 1, math.MaxUint} {
+		checkConversion(t, safe.UintToUint64, v)
+	}
+}
+
+// TestUintToUintptr tests the conversion from uint to uintptr at the boundaries of both types.
+func TestUintToUintptr(t *testing.T) {
+	for _, v := range []uint{0, 1, math.MaxUint} {
+		checkConversion(t, safe.UintToUintptr, v)
+	}
+}
+
+// TestUint8ToInt tests the conversion from uint8 to int at the boundaries of both types.
+func TestUint8ToInt(t *testing.T) {
+	for _, v := range []uint8{0, 1, math.MaxUint8} {
+		checkConversion(t, safe.Uint8ToInt, v)
+	}
+}
+
+// TestUint8ToInt8 tests the conversion from uint8 to int8 at the boundaries of both types.
+func TestUint8ToInt8(t *testing.T) {
+	for _, v := range []uint8{0, 1, math.MaxUint8, math.MaxInt8, math.MaxInt8 + 1} {
+		checkConversion(t, safe.Uint8ToInt8, v)
+	}
+}
+
+// TestUint8ToInt16 tests the conversion from uint8 to int16 at the boundaries of both types.
+func TestUint8ToInt16(t *testing.T) {
+	for _, v := range []uint8{0, 1, math.MaxUint8} {
+		checkConversion(t, safe.Uint8ToInt16, v)
+	}
+}
+
+// TestUint8ToInt32 tests the conversion from uint8 to int32 at the boundaries of both types.
+func TestUint8ToInt32(t *testing.T) {
+	for _, v := range []uint8{0, 1, math.MaxUint8} {
+		checkConversion(t, safe.Uint8ToInt32, v)
+	}
+}
+
+// TestUint8ToInt64 tests the conversion from uint8 to int64 at the boundaries of both types.
+func TestUint8ToInt64(t *testing.T) {
+	for _, v := range []uint8{0, 1, math.MaxUint8} {
+		checkConversion(t, safe.Uint8ToInt64, v)
+	}
+}
+
+// TestUint8ToUint tests the conversion from uint8 to uint at the boundaries of both types.
+func TestUint8ToUint(t *testing.T) {
+	for _, v := range []uint8{0, 1, math.MaxUint8} {
+		checkConversion(t, safe.Uint8ToUint, v)
+	}
+}
+
+// TestUint8ToUint16 tests the conversion from uint8 to uint16 at the boundaries of both types.
+func TestUint8ToUint16(t *testing.T) {
+	for _, v := range []uint8{0, 1, math.MaxUint8} {
+		checkConversion(t, safe.Uint8ToUint16, v)
+	}
+}
+
+// TestUint8ToUint32 tests the conversion from uint8 to uint32 at the boundaries of both types.
+func TestUint8ToUint32(t *testing.T) {
+	for _, v := range []uint8{0, 1, math.MaxUint8} {
+		checkConversion(t, safe.Uint8ToUint32, v)
+	}
+}
+
+// TestUint8ToUint64 tests the conversion from uint8 to uint64 at the boundaries of both types.
+func TestUint8ToUint64(t *testing.T) {
+	for _, v := range []uint8{0, 1, math.MaxUint8} {
+		checkConversion(t, safe.Uint8ToUint64, v)
+	}
+}
+
+// TestUint8ToUintptr tests the conversion from uint8 to uintptr at the boundaries of both types.
+func TestUint8ToUintptr(t *testing.T) {
+	for _, v := range []uint8{0, 1, math.MaxUint8} {
+		checkConversion(t, safe.Uint8ToUintptr, v)
+	}
+}
+
+// TestUint16ToInt tests the conversion from uint16 to int at the boundaries of both types.
+func TestUint16ToInt(t *testing.T) {
+	for _, v := range []uint16{0, 1, math.MaxUint16} {
+		checkConversion(t, safe.Uint16ToInt, v)
+	}
+}
+
+// TestUint16ToInt8 tests the conversion from uint16 to int8 at the boundaries of both types.
+func TestUint16ToInt8(t *testing.T) {
+	for _, v := range []uint16{0, 1, math.MaxUint16, math.MaxInt8, math.MaxInt8 + 1} {
+		checkConversion(t, safe.Uint16ToInt8, v)
+	}
+}
+
+// TestUint16ToInt16 tests the conversion from uint16 to int16 at the boundaries of both types.
+func TestUint16ToInt16(t *testing.T) {
+	for _, v := range []uint16{0, 1, math.MaxUint16, math.MaxInt16, math.MaxInt16 + 1} {
+		checkConversion(t, safe.Uint16ToInt16, v)
+	}
+}
+
+// TestUint16ToInt32 tests the conversion from uint16 to int32 at the boundaries of both types.
+func TestUint16ToInt32(t *testing.T) {
+	for _, v := range []uint16{0, 1, math.MaxUint16} {
+		checkConversion(t, safe.Uint16ToInt32, v)
+	}
+}
+
+// TestUint16ToInt64 tests the conversion from uint16 to int64 at the boundaries of both types.
+func TestUint16ToInt64(t *testing.T) {
+	for _, v := range []uint16{0, 1, math.MaxUint16} {
+		checkConversion(t, safe.Uint16ToInt64, v)
+	}
+}
+
+// TestUint16ToUint tests the conversion from uint16 to uint at the boundaries of both types.
+func TestUint16ToUint(t *testing.T) {
+	for _, v := range []uint16{0, 1, math.MaxUint16} {
+		checkConversion(t, safe.Uint16ToUint, v)
+	}
+}
+
+// TestUint16ToUint8 tests the conversion from uint16 to uint8 at the boundaries of both types.
+func TestUint16ToUint8(t *testing.T) {
+	for _, v := range []uint16{0, 1, math.MaxUint16, math.MaxUint8, math.MaxUint8 + 1} {
+		checkConversion(t, safe.Uint16ToUint8, v)
+	}
+}
+
+// TestUint16ToUint32 tests the conversion from uint16 to uint32 at the boundaries of both types.
+func TestUint16ToUint32(t *testing.T) {
+	for _, v := range []uint16{0, 1, math.MaxUint16} {
+		checkConversion(t, safe.Uint16ToUint32, v)
+	}
+}
+
+// TestUint16ToUint64 tests the conversion from uint16 to uint64 at the boundaries of both types.
+func TestUint16ToUint64(t *testing.T) {
+	for _, v := range []uint16{0, 1, math.MaxUint16} {
+		checkConversion(t, safe.Uint16ToUint64, v)
+	}
+}
+
+// TestUint16ToUintptr tests the conversion from uint16 to uintptr at the boundaries of both types.
+func TestUint16ToUintptr(t *testing.T) {
+	for _, v := range []uint16{0, 1, math.MaxUint16} {
+		checkConversion(t, safe.Uint16ToUintptr, v)
+	}
+}
+
+// TestUint32ToInt tests the conversion from uint32 to int at the boundaries of both types.
+func TestUint32ToInt(t *testing.T) {
+	for _, v := range []uint32{0, 1, math.MaxUint32} {
+		checkConversion(t, safe.Uint32ToInt, v)
+	}
+}
+
+// TestUint32ToInt8 tests the conversion from uint32 to int8 at the boundaries of both types.
+func TestUint32ToInt8(t *testing.T) {
+	for _, v := range []uint32{0, 1, math.MaxUint32, math.MaxInt8, math.MaxInt8 + 1} {
+		checkConversion(t, safe.Uint32ToInt8, v)
+	}
+}
+
+// TestUint32ToInt16 tests the conversion from uint32 to int16 at the boundaries of both types.
+func TestUint32ToInt16(t *testing.T) {
+	for _, v := range []uint32{0, 1, math.MaxUint32, math.MaxInt16, math.MaxInt16 + 1} {
+		checkConversion(t, safe.Uint32ToInt16, v)
+	}
+}
+
+// TestUint32ToUint tests the conversion from uint32 to uint at the boundaries of both types.
+func TestUint32ToUint(t *testing.T) {
+	for _, v := range []uint32{0, 1, math.MaxUint32} {
+		checkConversion(t, safe.Uint32ToUint, v)
+	}
+}
+
+// TestUint32ToUint16 tests the conversion from uint32 to uint16 at the boundaries of both types.
+func TestUint32ToUint16(t *testing.T) {
+	for _, v := range []uint32{0, 1, math.MaxUint32, math.MaxUint16, math.MaxUint16 + 1} {
+		checkConversion(t, safe.Uint32ToUint16, v)
+	}
+}
+
+// TestUint32ToUintptr tests the conversion from uint32 to uintptr at the boundaries of both types.
+func TestUint32ToUintptr(t *testing.T) {
+	for _, v := range []uint32{0, 1, math.MaxUint32} {
+		checkConversion(t, safe.Uint32ToUintptr, v)
+	}
+}
+
+// TestUint64ToInt8 tests the conversion from uint64 to int8 at the boundaries of both types.
+func TestUint64ToInt8(t *testing.T) {
+	for _, v := range []uint64{0, 1, math.MaxUint64, math.MaxInt8, math.MaxInt8 + 1} {
+		checkConversion(t, safe.Uint64ToInt8, v)
+	}
+}
+
+// TestUint64ToInt16 tests the conversion from uint64 to int16 at the boundaries of both types.
+func TestUint64ToInt16(t *testing.T) {
+	for _, v := range []uint64{0, 1, math.MaxUint64, math.MaxInt16, math.MaxInt16 + 1} {
+		checkConversion(t, safe.Uint64ToInt16, v)
+	}
+}
+
+// TestUint64ToUint tests the conversion from uint64 to uint at the boundaries of both types.
+func TestUint64ToUint(t *testing.T) {
+	for _, v := range []uint64{0, 1, math.MaxUint64} {
+		checkConversion(t, safe.Uint64ToUint, v)
+	}
+}
+
+// TestUint64ToUint8 tests the conversion from uint64 to uint8 at the boundaries of both types.
+func TestUint64ToUint8(t *testing.T) {
+	for _, v := range []uint64{0, 1, math.MaxUint64, math.MaxUint8, math.MaxUint8 + 1} {
+		checkConversion(t, safe.Uint64ToUint8, v)
+	}
+}
+
+// TestUintptrToInt8 tests the conversion from uintptr to int8 at the boundaries of both types.
+func TestUintptrToInt8(t *testing.T) {
+	for _, v := range []uintptr{0, 1, ^uintptr(0), math.MaxInt8, math.MaxInt8 + 1} {
+		checkConversion(t, safe.UintptrToInt8, v)
+	}
+}
+
+// TestUintptrToInt16 tests the conversion from uintptr to int16 at the boundaries of both types.
+func TestUintptrToInt16(t *testing.T) {
+	for _, v := range []uintptr{0, 1, ^uintptr(0), math.MaxInt16, math.MaxInt16 + 1} {
+		checkConversion(t, safe.UintptrToInt16, v)
+	}
+}
+
+// TestUintptrToInt32 tests the conversion from uintptr to int32 at the boundaries of both types.
+func TestUintptrToInt32(t *testing.T) {
+	for _, v := range []uintptr{0, 1, ^uintptr(0), math.MaxInt32, math.MaxInt32 + 1} {
+		checkConversion(t, safe.UintptrToInt32, v)
+	}
+}
+
+// TestUintptrToInt64 tests the conversion from uintptr to int64 at the boundaries of both types.
+func TestUintptrToInt64(t *testing.T) {
+	for _, v := range []uintptr{0, 1, ^uintptr(0)} {
+		checkConversion(t, safe.UintptrToInt64, v)
+	}
+}
+
+// TestUintptrToUint tests the conversion from uintptr to uint at the boundaries of both types.
+func TestUintptrToUint(t *testing.T) {
+	for _, v := range []uintptr{0, 1, ^uintptr(0)} {
+		checkConversion(t, safe.UintptrToUint, v)
+	}
+}
+
+// TestUintptrToUint8 tests the conversion from uintptr to uint8 at the boundaries of both types.
+func TestUintptrToUint8(t *testing.T) {
+	for _, v := range []uintptr{0, 1, ^uintptr(0), math.MaxUint8, math.MaxUint8 + 1} {
+		checkConversion(t, safe.UintptrToUint8, v)
+	}
+}
+
+// TestUintptrToUint16 tests the conversion from uintptr to uint16 at the boundaries of both types.
+func TestUintptrToUint16(t *testing.T) {
+	for _, v := range []uintptr{0, 1, ^uintptr(0), math.MaxUint16, math.MaxUint16 + 1} {
+		checkConversion(t, safe.UintptrToUint16, v)
+	}
+}
+
+// TestUintptrToUint32 tests the conversion from uintptr to uint32 at the boundaries of both types.
+func TestUintptrToUint32(t *testing.T) {
+	for _, v := range []uintptr{0, 1, ^uintptr(0), math.MaxUint32} {
+		checkConversion(t, safe.UintptrToUint32, v)
+	}
+}
+
+// TestUintptrToUint64 tests the conversion from uintptr to uint64 at the boundaries of both types.
+func TestUintptrToUint64(t *testing.T) {
+	for _, v := range []uintptr{0, 1, ^uintptr(0)} {
+		checkConversion(t, safe.UintptrToUint64, v)
+	}
+}
+
+// FuzzIntToInt8 validates IntToInt8 with random inputs.
+func FuzzIntToInt8(f *testing.F) {
+	f.Add(int(math.MinInt))
+	f.Add(int(math.MinInt + 1))
+	f.Add(int(-1))
+	f.Add(int(0))
+	f.Add(int(1))
+	f.Add(int(math.MaxInt - 1))
+	f.Add(int(math.MaxInt))
+	f.Add(int(math.MinInt8 - 1))
+	f.Add(int(math.MinInt8))
+	f.Add(int(math.MaxInt8))
+	f.Add(int(math.MaxInt8 + 1))
+	f.Fuzz(func(t *testing.T, v int) {
+		checkConversion(t, safe.IntToInt8, v)
+	})
+}
+
+// FuzzIntToInt64 validates IntToInt64 with random inputs.
+func FuzzIntToInt64(f *testing.F) {
+	f.Add(int(math.MinInt))
+	f.Add(int(math.MinInt + 1))
+	f.Add(int(-1))
+	f.Add(int(0))
+	f.Add(int(1))
+	f.Add(int(math.MaxInt - 1))
+	f.Add(int(math.MaxInt))
+	f.Fuzz(func(t *testing.T, v int) {
+		checkConversion(t, safe.IntToInt64, v)
+	})
+}
+
+// FuzzIntToUint validates IntToUint with random inputs.
+func FuzzIntToUint(f *testing.F) {
+	f.Add(int(math.MinInt))
+	f.Add(int(math.MinInt + 1))
+	f.Add(int(-1))
+	f.Add(int(0))
+	f.Add(int(1))
+	f.Add(int(math.MaxInt - 1))
+	f.Add(int(math.MaxInt))
+	f.Fuzz(func(t *testing.T, v int) {
+		checkConversion(t, safe.IntToUint, v)
+	})
+}
+
+// FuzzIntToUint8 validates IntToUint8 with random inputs.
+func FuzzIntToUint8(f *testing.F) {
+	f.Add(int(math.MinInt))
+	f.Add(int(math.MinInt + 1))
+	f.Add(int(-1))
+	f.Add(int(0))
+	f.Add(int(1))
+	f.Add(int(math.MaxInt - 1))
+	f.Add(int(math.MaxInt))
+	f.Add(int(math.MaxUint8))
+	f.Add(int(math.MaxUint8 + 1))
+	f.Fuzz(func(t *testing.T, v int) {
+		checkConversion(t, safe.IntToUint8, v)
+	})
+}
+
+// FuzzInt8ToInt validates Int8ToInt with random inputs.
+func FuzzInt8ToInt(f *testing.F) {
+	f.Add(int8(math.MinInt8))
+	f.Add(int8(math.MinInt8 + 1))
+	f.Add(int8(-1))
+	f.Add(int8(0))
+	f.Add(int8(1))
+	f.Add(int8(math.MaxInt8 - 1))
+	f.Add(int8(math.MaxInt8))
+	f.Fuzz(func(t *testing.T, v int8) {
+		checkConversion(t, safe.Int8ToInt, v)
+	})
+}
+
+// FuzzInt8ToInt16 validates Int8ToInt16 with random inputs.
+func FuzzInt8ToInt16(f *testing.F) {
+	f.Add(int8(math.MinInt8))
+	f.Add(int8(math.MinInt8 + 1))
+	f.Add(int8(-1))
+	f.Add(int8(0))
+	f.Add(int8(1))
+	f.Add(int8(math.MaxInt8 - 1))
+	f.Add(int8(math.MaxInt8))
+	f.Fuzz(func(t *testing.T, v int8) {
+		checkConversion(t, safe.Int8ToInt16, v)
+	})
+}
+
+// FuzzInt8ToInt32 validates Int8ToInt32 with random inputs.
+func FuzzInt8ToInt32(f *testing.F) {
+	f.Add(int8(math.MinInt8))
+	f.Add(int8(math.MinInt8 + 1))
+	f.Add(int8(-1))
+	f.Add(int8(0))
+	f.Add(int8(1))
+	f.Add(int8(math.MaxInt8 - 1))
+	f.Add(int8(math.MaxInt8))
+	f.Fuzz(func(t *testing.T, v int8) {
+		checkConversion(t, safe.Int8ToInt32, v)
+	})
+}
+
+// FuzzInt8ToInt64 validates Int8ToInt64 with random inputs.
+func FuzzInt8ToInt64(f *testing.F) {
+	f.Add(int8(math.MinInt8))
+	f.Add(int8(math.MinInt8 + 1))
+	f.Add(int8(-1))
+	f.Add(int8(0))
+	f.Add(int8(1))
+	f.Add(int8(math.MaxInt8 - 1))
+	f.Add(int8(math.MaxInt8))
+	f.Fuzz(func(t *testing.T, v int8) {
+		checkConversion(t, safe.Int8ToInt64, v)
+	})
+}
+
+// FuzzInt8ToUint validates Int8ToUint with random inputs.
+func FuzzInt8ToUint(f *testing.F) {
+	f.Add(int8(math.MinInt8))
+	f.Add(int8(math.MinInt8 + 1))
+	f.Add(int8(-1))
+	f.Add(int8(0))
+	f.Add(int8(1))
+	f.Add(int8(math.MaxInt8 - 1))
+	f.Add(int8(math.MaxInt8))
+	f.Fuzz(func(t *testing.T, v int8) {
+		checkConversion(t, safe.Int8ToUint, v)
+	})
+}
+
+// FuzzInt8ToUint8 validates Int8ToUint8 with random inputs.
+func FuzzInt8ToUint8(f *testing.F) {
+	f.Add(int8(math.MinInt8))
+	f.Add(int8(math.MinInt8 + 1))
+	f.Add(int8(-1))
+	f.Add(int8(0))
+	f.Add(int8(1))
+	f.Add(int8(math.MaxInt8 - 1))
+	f.Add(int8(math.MaxInt8))
+	f.Fuzz(func(t *testing.T, v int8) {
+		checkConversion(t, safe.Int8ToUint8, v)
+	})
+}
+
+// FuzzInt8ToUint16 validates Int8ToUint16 with random inputs.
+func FuzzInt8ToUint16(f *testing.F) {
+	f.Add(int8(math.MinInt8))
+	f.Add(int8(math.MinInt8 + 1))
+	f.Add(int8(-1))
+	f.Add(int8(0))
+	f.Add(int8(1))
+	f.Add(int8(math.MaxInt8 - 1))
+	f.Add(int8(math.MaxInt8))
+	f.Fuzz(func(t *testing.T, v int8) {
+		checkConversion(t, safe.Int8ToUint16, v)
+	})
+}
+
+// FuzzInt8ToUint32 validates Int8ToUint32 with random inputs.
+func FuzzInt8ToUint32(f *testing.F) {
+	f.Add(int8(math.MinInt8))
+	f.Add(int8(math.MinInt8 + 1))
+	f.Add(int8(-1))
+	f.Add(int8(0))
+	f.Add(int8(1))
+	f.Add(int8(math.MaxInt8 - 1))
+	f.Add(int8(math.MaxInt8))
+	f.Fuzz(func(t *testing.T, v int8) {
+		checkConversion(t, safe.Int8ToUint32, v)
+	})
+}
+
+// FuzzInt8ToUint64 validates Int8ToUint64 with random inputs.
+func FuzzInt8ToUint64(f *testing.F) {
+	f.Add(int8(math.MinInt8))
+	f.Add(int8(math.MinInt8 + 1))
+	f.Add(int8(-1))
+	f.Add(int8(0))
+	f.Add(int8(1))
+	f.Add(int8(math.MaxInt8 - 1))
+	f.Add(int8(math.MaxInt8))
+	f.Fuzz(func(t *testing.T, v int8) {
+		checkConversion(t, safe.Int8ToUint64, v)
+	})
+}
+
+// FuzzInt8ToUintptr validates Int8ToUintptr with random inputs.
+func FuzzInt8ToUintptr(f *testing.F) {
+	f.Add(int8(math.MinInt8))
+	f.Add(int8(math.MinInt8 + 1))
+	f.Add(int8(-1))
+	f.Add(int8(0))
+	f.Add(int8(1))
+	f.Add(int8(math.MaxInt8 - 1))
+	f.Add(int8(math.MaxInt8))
+	f.Fuzz(func(t *testing.T, v int8) {
+		checkConversion(t, safe.Int8ToUintptr, v)
+	})
+}
+
+// FuzzInt16ToInt validates Int16ToInt with random inputs.
+func FuzzInt16ToInt(f *testing.F) {
+	f.Add(int16(math.MinInt16))
+	f.Add(int16(math.MinInt16 + 1))
+	f.Add(int16(-1))
+	f.Add(int16(0))
+	f.Add(int16(1))
+	f.Add(int16(math.MaxInt16 - 1))
+	f.Add(int16(math.MaxInt16))
+	f.Fuzz(func(t *testing.T, v int16) {
+		checkConversion(t, safe.Int16ToInt, v)
+	})
+}
+
+// FuzzInt16ToInt8 validates Int16ToInt8 with random inputs.
+func FuzzInt16ToInt8(f *testing.F) {
+	f.Add(int16(math.MinInt16))
+	f.Add(int16(math.MinInt16 + 1))
+	f.Add(int16(-1))
+	f.Add(int16(0))
+	f.Add(int16(1))
+	f.Add(int16(math.MaxInt16 - 1))
+	f.Add(int16(math.MaxInt16))
+	f.Add(int16(math.MinInt8 - 1))
+	f.Add(int16(math.MinInt8))
+	f.Add(int16(math.MaxInt8))
+	f.Add(int16(math.MaxInt8 + 1))
+	f.Fuzz(func(t *testing.T, v int16) {
+		checkConversion(t, safe.Int16ToInt8, v)
+	})
+}
+
+// FuzzInt16ToInt32 validates Int16ToInt32 with random inputs.
+func FuzzInt16ToInt32(f *testing.F) {
+	f.Add(int16(math.MinInt16))
+	f.Add(int16(math.MinInt16 + 1))
+	f.Add(int16(-1))
+	f.Add(int16(0))
+	f.Add(int16(1))
+	f.Add(int16(math.MaxInt16 - 1))
+	f.Add(int16(math.MaxInt16))
+	f.Fuzz(func(t *testing.T, v int16) {
+		checkConversion(t, safe.Int16ToInt32, v)
+	})
+}
+
+// FuzzInt16ToInt64 validates Int16ToInt64 with random inputs.
+func FuzzInt16ToInt64(f *testing.F) {
+	f.Add(int16(math.MinInt16))
+	f.Add(int16(math.MinInt16 + 1))
+	f.Add(int16(-1))
+	f.Add(int16(0))
+	f.Add(int16(1))
+	f.Add(int16(math.MaxInt16 - 1))
+	f.Add(int16(math.MaxInt16))
+	f.Fuzz(func(t *testing.T, v int16) {
+		checkConversion(t, safe.Int16ToInt64, v)
+	})
+}
+
+// FuzzInt16ToUint validates Int16ToUint with random inputs.
+func FuzzInt16ToUint(f *testing.F) {
+	f.Add(int16(math.MinInt16))
+	f.Add(int16(math.MinInt16 + 1))
+	f.Add(int16(-1))
+	f.Add(int16(0))
+	f.Add(int16(1))
+	f.Add(int16(math.MaxInt16 - 1))
+	f.Add(int16(math.MaxInt16))
+	f.Fuzz(func(t *testing.T, v int16) {
+		checkConversion(t, safe.Int16ToUint, v)
+	})
+}
+
+// FuzzInt16ToUint8 validates Int16ToUint8 with random inputs.
+func FuzzInt16ToUint8(f *testing.F) {
+	f.Add(int16(math.MinInt16))
+	f.Add(int16(math.MinInt16 + 1))
+	f.Add(int16(-1))
+	f.Add(int16(0))
+	f.Add(int16(1))
+	f.Add(int16(math.MaxInt16 - 1))
+	f.Add(int16(math.MaxInt16))
+	f.Add(int16(math.MaxUint8))
+	f.Add(int16(math.MaxUint8 + 1))
+	f.Fuzz(func(t *testing.T, v int16) {
+		checkConversion(t, safe.Int16ToUint8, v)
+	})
+}
+
+// FuzzInt16ToUint16 validates Int16ToUint16 with random inputs.
+func FuzzInt16ToUint16(f *testing.F) {
+	f.Add(int16(math.MinInt16))
+	f.Add(int16(math.MinInt16 + 1))
+	f.Add(int16(-1))
+	f.Add(int16(0))
+	f.Add(int16(1))
+	f.Add(int16(math.MaxInt16 - 1))
+	f.Add(int16(math.MaxInt16))
+	f.Fuzz(func(t *testing.T, v int16) {
+		checkConversion(t, safe.Int16ToUint16, v)
+	})
+}
+
+// FuzzInt16ToUint32 validates Int16ToUint32 with random inputs.
+func FuzzInt16ToUint32(f *testing.F) {
+	f.Add(int16(math.MinInt16))
+	f.Add(int16(math.MinInt16 + 1))
+	f.Add(int16(-1))
+	f.Add(int16(0))
+	f.Add(int16(1))
+	f.Add(int16(math.MaxInt16 - 1))
+	f.Add(int16(math.MaxInt16))
+	f.Fuzz(func(t *testing.T, v int16) {
+		checkConversion(t, safe.Int16ToUint32, v)
+	})
+}
+
+// FuzzInt16ToUint64 validates Int16ToUint64 with random inputs.
+func FuzzInt16ToUint64(f *testing.F) {
+	f.Add(int16(math.MinInt16))
+	f.Add(int16(math.MinInt16 + 1))
+	f.Add(int16(-1))
+	f.Add(int16(0))
+	f.Add(int16(1))
+	f.Add(int16(math.MaxInt16 - 1))
+	f.Add(int16(math.MaxInt16))
+	f.Fuzz(func(t *testing.T, v int16) {
+		checkConversion(t, safe.Int16ToUint64, v)
+	})
+}
+
+// FuzzInt16ToUintptr validates Int16ToUintptr with random inputs.
+func FuzzInt16ToUintptr(f *testing.F) {
+	f.Add(int16(math.MinInt16))
+	f.Add(int16(math.MinInt16 + 1))
+	f.Add(int16(-1))
+	f.Add(int16(0))
+	f.Add(int16(1))
+	f.Add(int16(math.MaxInt16 - 1))
+	f.Add(int16(math.MaxInt16))
+	f.Fuzz(func(t *testing.T, v int16) {
+		checkConversion(t, safe.Int16ToUintptr, v)
+	})
+}
+
+// FuzzInt32ToInt validates Int32ToInt with random inputs.
+func FuzzInt32ToInt(f *testing.F) {
+	f.Add(int32(math.MinInt32))
+	f.Add(int32(math.MinInt32 + 1))
+	f.Add(int32(-1))
+	f.Add(int32(0))
+	f.Add(int32(1))
+	f.Add(int32(math.MaxInt32 - 1))
+	f.Add(int32(math.MaxInt32))
+	f.Fuzz(func(t *testing.T, v int32) {
+		checkConversion(t, safe.Int32ToInt, v)
+	})
+}
+
+// FuzzInt32ToInt8 validates Int32ToInt8 with random inputs.
+func FuzzInt32ToInt8(f *testing.F) {
+	f.Add(int32(math.MinInt32))
+	f.Add(int32(math.MinInt32 + 1))
+	f.Add(int32(-1))
+	f.Add(int32(0))
+	f.Add(int32(1))
+	f.Add(int32(math.MaxInt32 - 1))
+	f.Add(int32(math.MaxInt32))
+	f.Add(int32(math.MinInt8 - 1))
+	f.Add(int32(math.MinInt8))
+	f.Add(int32(math.MaxInt8))
+	f.Add(int32(math.MaxInt8 + 1))
+	f.Fuzz(func(t *testing.T, v int32) {
+		checkConversion(t, safe.Int32ToInt8, v)
+	})
+}
+
+// FuzzInt32ToInt16 validates Int32ToInt16 with random inputs.
+func FuzzInt32ToInt16(f *testing.F) {
+	f.Add(int32(math.MinInt32))
+	f.Add(int32(math.MinInt32 + 1))
+	f.Add(int32(-1))
+	f.Add(int32(0))
+	f.Add(int32(1))
+	f.Add(int32(math.MaxInt32 - 1))
+	f.Add(int32(math.MaxInt32))
+	f.Add(int32(math.MinInt16 - 1))
+	f.Add(int32(math.MinInt16))
+	f.Add(int32(math.MaxInt16))
+	f.Add(int32(math.MaxInt16 + 1))
+	f.Fuzz(func(t *testing.T, v int32) {
+		checkConversion(t, safe.Int32ToInt16, v)
+	})
+}
+
+// FuzzInt32ToInt64 validates Int32ToInt64 with random inputs.
+func FuzzInt32ToInt64(f *testing.F) {
+	f.Add(int32(math.MinInt32))
+	f.Add(int32(math.MinInt32 + 1))
+	f.Add(int32(-1))
+	f.Add(int32(0))
+	f.Add(int32(1))
+	f.Add(int32(math.MaxInt32 - 1))
+	f.Add(int32(math.MaxInt32))
+	f.Fuzz(func(t *testing.T, v int32) {
+		checkConversion(t, safe.Int32ToInt64, v)
+	})
+}
+
+// FuzzInt32ToUint validates Int32ToUint with random inputs.
+func FuzzInt32ToUint(f *testing.F) {
+	f.Add(int32(math.MinInt32))
+	f.Add(int32(math.MinInt32 + 1))
+	f.Add(int32(-1))
+	f.Add(int32(0))
+	f.Add(int32(1))
+	f.Add(int32(math.MaxInt32 - 1))
+	f.Add(int32(math.MaxInt32))
+	f.Fuzz(func(t *testing.T, v int32) {
+		checkConversion(t, safe.Int32ToUint, v)
+	})
+}
+
+// FuzzInt32ToUint8 validates Int32ToUint8 with random inputs.
+func FuzzInt32ToUint8(f *testing.F) {
+	f.Add(int32(math.MinInt32))
+	f.Add(int32(math.MinInt32 + 1))
+	f.Add(int32(-1))
+	f.Add(int32(0))
+	f.Add(int32(1))
+	f.Add(int32(math.MaxInt32 - 1))
+	f.Add(int32(math.MaxInt32))
+	f.Add(int32(math.MaxUint8))
+	f.Add(int32(math.MaxUint8 + 1))
+	f.Fuzz(func(t *testing.T, v int32) {
+		checkConversion(t, safe.Int32ToUint8, v)
+	})
+}
+
+// FuzzInt32ToUint16 validates Int32ToUint16 with random inputs.
+func FuzzInt32ToUint16(f *testing.F) {
+	f.Add(int32(math.MinInt32))
+	f.Add(int32(math.MinInt32 + 1))
+	f.Add(int32(-1))
+	f.Add(int32(0))
+	f.Add(int32(1))
+	f.Add(int32(math.MaxInt32 - 1))
+	f.Add(int32(math.MaxInt32))
+	f.Add(int32(math.MaxUint16))
+	f.Add(int32(math.MaxUint16 + 1))
+	f.Fuzz(func(t *testing.T, v int32) {
+		checkConversion(t, safe.Int32ToUint16, v)
+	})
+}
+
+// FuzzInt32ToUint64 validates Int32ToUint64 with random inputs.
+func FuzzInt32ToUint64(f *testing.F) {
+	f.Add(int32(math.MinInt32))
+	f.Add(int32(math.MinInt32 + 1))
+	f.Add(int32(-1))
+	f.Add(int32(0))
+	f.Add(int32(1))
+	f.Add(int32(math.MaxInt32 - 1))
+	f.Add(int32(math.MaxInt32))
+	f.Fuzz(func(t *testing.T, v int32) {
+		checkConversion(t, safe.Int32ToUint64, v)
+	})
+}
+
+// FuzzInt32ToUintptr validates Int32ToUintptr with random inputs.
+func FuzzInt32ToUintptr(f *testing.F) {
+	f.Add(int32(math.MinInt32))
+	f.Add(int32(math.MinInt32 + 1))
+	f.Add(int32(-1))
+	f.Add(int32(0))
+	f.Add(int32(1))
+	f.Add(int32(math.MaxInt32 - 1))
+	f.Add(int32(math.MaxInt32))
+	f.Fuzz(func(t *testing.T, v int32) {
+		checkConversion(t, safe.Int32ToUintptr, v)
+	})
+}
+
+// FuzzInt64ToInt validates Int64ToInt with random inputs.
+func FuzzInt64ToInt(f *testing.F) {
+	f.Add(int64(math.MinInt64))
+	f.Add(int64(math.MinInt64 + 1))
+	f.Add(int64(-1))
+	f.Add(int64(0))
+	f.Add(int64(1))
+	f.Add(int64(math.MaxInt64 - 1))
+	f.Add(int64(math.MaxInt64))
+	f.Fuzz(func(t *testing.T, v int64) {
+		checkConversion(t, safe.Int64ToInt, v)
+	})
+}
+
+// FuzzInt64ToInt8 validates Int64ToInt8 with random inputs.
+func FuzzInt64ToInt8(f *testing.F) {
+	f.Add(int64(math.MinInt64))
+	f.Add(int64(math.MinInt64 + 1))
+	f.Add(int64(-1))
+	f.Add(int64(0))
+	f.Add(int64(1))
+	f.Add(int64(math.MaxInt64 - 1))
+	f.Add(int64(math.MaxInt64))
+	f.Add(int64(math.MinInt8 - 1))
+	f.Add(int64(math.MinInt8))
+	f.Add(int64(math.MaxInt8))
+	f.Add(int64(math.MaxInt8 + 1))
+	f.Fuzz(func(t *testing.T, v int64) {
+		checkConversion(t, safe.Int64ToInt8, v)
+	})
+}
+
+// FuzzInt64ToInt16 validates Int64ToInt16 with random inputs.
+func FuzzInt64ToInt16(f *testing.F) {
+	f.Add(int64(math.MinInt64))
+	f.Add(int64(math.MinInt64 + 1))
+	f.Add(int64(-1))
+	f.Add(int64(0))
+	f.Add(int64(1))
+	f.Add(int64(math.MaxInt64 - 1))
+	f.Add(int64(math.MaxInt64))
+	f.Add(int64(math.MinInt16 - 1))
+	f.Add(int64(math.MinInt16))
+	f.Add(int64(math.MaxInt16))
+	f.Add(int64(math.MaxInt16 + 1))
+	f.Fuzz(func(t *testing.T, v int64) {
+		checkConversion(t, safe.Int64ToInt16, v)
+	})
+}
+
+// FuzzInt64ToUint validates Int64ToUint with random inputs.
+func FuzzInt64ToUint(f *testing.F) {
+	f.Add(int64(math.MinInt64))
+	f.Add(int64(math.MinInt64 + 1))
+	f.Add(int64(-1))
+	f.Add(int64(0))
+	f.Add(int64(1))
+	f.Add(int64(math.MaxInt64 - 1))
+	f.Add(int64(math.MaxInt64))
+	f.Fuzz(func(t *testing.T, v int64) {
+		checkConversion(t, safe.Int64ToUint, v)
+	})
+}
+
+// FuzzInt64ToUint8 validates Int64ToUint8 with random inputs.
+func FuzzInt64ToUint8(f *testing.F) {
+	f.Add(int64(math.MinInt64))
+	f.Add(int64(math.MinInt64 + 1))
+	f.Add(int64(-1))
+	f.Add(int64(0))
+	f.Add(int64(1))
+	f.Add(int64(math.MaxInt64 - 1))
+	f.Add(int64(math.MaxInt64))
+	f.Add(int64(math.MaxUint8))
+	f.Add(int64(math.MaxUint8 + 1))
+	f.Fuzz(func(t *testing.T, v int64) {
+		checkConversion(t, safe.Int64ToUint8, v)
+	})
+}
+
+// FuzzInt64ToUint16 validates Int64ToUint16 with random inputs.
+func FuzzInt64ToUint16(f *testing.F) {
+	f.Add(int64(math.MinInt64))
+	f.Add(int64(math.MinInt64 + 1))
+	f.Add(int64(-1))
+	f.Add(int64(0))
+	f.Add(int64(1))
+	f.Add(int64(math.MaxInt64 - 1))
+	f.Add(int64(math.MaxInt64))
+	f.Add(int64(math.MaxUint16))
+	f.Add(int64(math.MaxUint16 + 1))
+	f.Fuzz(func(t *testing.T, v int64) {
+		checkConversion(t, safe.Int64ToUint16, v)
+	})
+}
+
+// FuzzUintToInt validates UintToInt with random inputs.
+func FuzzUintToInt(f *testing.F) {
+	f.Add(uint(0))
+	f.Add(uint(1))
+	f.Add(uint(math.MaxUint))
+	f.Add(uint(math.MaxInt))
+	f.Add(uint(math.MaxInt + 1))
+	f.Fuzz(func(t *testing.T, v uint) {
+		checkConversion(t, safe.UintToInt, v)
+	})
+}
+
+// FuzzUintToInt8 validates UintToInt8 with random inputs.
+func FuzzUintToInt8(f *testing.F) {
+	f.Add(uint(0))
+	f.Add(uint(1))
+	f.Add(uint(math.MaxUint))
+	f.Add(uint(math.MaxInt8))
+	f.Add(uint(math.MaxInt8 + 1))
+	f.Fuzz(func(t *testing.T, v uint) {
+		checkConversion(t, safe.UintToInt8, v)
+	})
+}
+
+// FuzzUintToInt16 validates UintToInt16 with random inputs.
+func FuzzUintToInt16(f *testing.F) {
+	f.Add(uint(0))
+	f.Add(uint(1))
+	f.Add(uint(math.MaxUint))
+	f.Add(uint(math.MaxInt16))
+	f.Add(uint(math.MaxInt16 + 1))
+	f.Fuzz(func(t *testing.T, v uint) {
+		checkConversion(t, safe.UintToInt16, v)
+	})
+}
+
+// FuzzUintToInt32 validates UintToInt32 with random inputs.
+func FuzzUintToInt32(f *testing.F) {
+	f.Add(uint(0))
+	f.Add(uint(1))
+	f.Add(uint(math.MaxUint))
+	f.Add(uint(math.MaxInt32))
+	f.Add(uint(math.MaxInt32 + 1))
+	f.Fuzz(func(t *testing.T, v uint) {
+		checkConversion(t, safe.UintToInt32, v)
+	})
+}
+
+// FuzzUintToInt64 validates UintToInt64 with random inputs.
+func FuzzUintToInt64(f *testing.F) {
+	f.Add(uint(0))
+	f.Add(uint(1))
+	f.Add(uint(math.MaxUint))
+	f.Fuzz(func(t *testing.T, v uint) {
+		checkConversion(t, safe.UintToInt64, v)
+	})
+}
+
+// FuzzUintToUint8 validates UintToUint8 with random inputs.
+func FuzzUintToUint8(f *testing.F) {
+	f.Add(uint(0))
+	f.Add(uint(1))
+	f.Add(uint(math.MaxUint))
+	f.Add(uint(math.MaxUint8))
+	f.Add(uint(math.MaxUint8 + 1))
+	f.Fuzz(func(t *testing.T, v uint) {
+		checkConversion(t, safe.UintToUint8, v)
+	})
+}
+
+// FuzzUintToUint16 validates UintToUint16 with random inputs.
+func FuzzUintToUint16(f *testing.F) {
+	f.Add(uint(0))
+	f.Add(uint(1))
+	f.Add(uint(math.MaxUint))
+	f.Add(uint(math.MaxUint16))
+	f.Add(uint(math.MaxUint16 + 1))
+	f.Fuzz(func(t *testing.T, v uint) {
+		checkConversion(t, safe.UintToUint16, v)
+	})
+}
+
+// FuzzUintToUint64 validates UintToUint64 with random inputs.
+func FuzzUintToUint64(f *testing.F) {
+	f.Add(uint(0))
+	f.Add(uint(1))
+	f.Add(uint(math.MaxUint))
+	f.Fuzz(func(t *testing.T, v uint) {
+		checkConversion(t, safe.UintToUint64, v)
+	})
+}
+
+// FuzzUintToUintptr validates UintToUintptr with random inputs.
+func FuzzUintToUintptr(f *testing.F) {
+	f.Add(uint(0))
+	f.Add(uint(1))
+	f.Add(uint(math.MaxUint))
+	f.Fuzz(func(t *testing.T, v uint) {
+		checkConversion(t, safe.UintToUintptr, v)
+	})
+}
+
+// FuzzUint8ToInt validates Uint8ToInt with random inputs.
+func FuzzUint8ToInt(f *testing.F) {
+	f.Add(uint8(0))
+	f.Add(uint8(1))
+	f.Add(uint8(math.MaxUint8))
+	f.Fuzz(func(t *testing.T, v uint8) {
+		checkConversion(t, safe.Uint8ToInt, v)
+	})
+}
+
+// FuzzUint8ToInt8 validates Uint8ToInt8 with random inputs.
+func FuzzUint8ToInt8(f *testing.F) {
+	f.Add(uint8(0))
+	f.Add(uint8(1))
+	f.Add(uint8(math.MaxUint8))
+	f.Add(uint8(math.MaxInt8))
+	f.Add(uint8(math.MaxInt8 + 1))
+	f.Fuzz(func(t *testing.T, v uint8) {
+		checkConversion(t, safe.Uint8ToInt8, v)
+	})
+}
+
+// FuzzUint8ToInt16 validates Uint8ToInt16 with random inputs.
+func FuzzUint8ToInt16(f *testing.F) {
+	f.Add(uint8(0))
+	f.Add(uint8(1))
+	f.Add(uint8(math.MaxUint8))
+	f.Fuzz(func(t *testing.T, v uint8) {
+		checkConversion(t, safe.Uint8ToInt16, v)
+	})
+}
+
+// FuzzUint8ToInt32 validates Uint8ToInt32 with random inputs.
+func FuzzUint8ToInt32(f *testing.F) {
+	f.Add(uint8(0))
+	f.Add(uint8(1))
+	f.Add(uint8(math.MaxUint8))
+	f.Fuzz(func(t *testing.T, v uint8) {
+		checkConversion(t, safe.Uint8ToInt32, v)
+	})
+}
+
+// FuzzUint8ToInt64 validates Uint8ToInt64 with random inputs.
+func FuzzUint8ToInt64(f *testing.F) {
+	f.Add(uint8(0))
+	f.Add(uint8(1))
+	f.Add(uint8(math.MaxUint8))
+	f.Fuzz(func(t *testing.T, v uint8) {
+		checkConversion(t, safe.Uint8ToInt64, v)
+	})
+}
+
+// FuzzUint8ToUint validates Uint8ToUint with random inputs.
+func FuzzUint8ToUint(f *testing.F) {
+	f.Add(uint8(0))
+	f.Add(uint8(1))
+	f.Add(uint8(math.MaxUint8))
+	f.Fuzz(func(t *testing.T, v uint8) {
+		checkConversion(t, safe.Uint8ToUint, v)
+	})
+}
+
+// FuzzUint8ToUint16 validates Uint8ToUint16 with random inputs.
+func FuzzUint8ToUint16(f *testing.F) {
+	f.Add(uint8(0))
+	f.Add(uint8(1))
+	f.Add(uint8(math.MaxUint8))
+	f.Fuzz(func(t *testing.T, v uint8) {
+		checkConversion(t, safe.Uint8ToUint16, v)
+	})
+}
+
+// FuzzUint8ToUint32 validates Uint8ToUint32 with random inputs.
+func FuzzUint8ToUint32(f *testing.F) {
+	f.Add(uint8(0))
+	f.Add(uint8(1))
+	f.Add(uint8(math.MaxUint8))
+	f.Fuzz(func(t *testing.T, v uint8) {
+		checkConversion(t, safe.Uint8ToUint32, v)
+	})
+}
+
+// FuzzUint8ToUint64 validates Uint8ToUint64 with random inputs.
+func FuzzUint8ToUint64(f *testing.F) {
+	f.Add(uint8(0))
+	f.Add(uint8(1))
+	f.Add(uint8(math.MaxUint8))
+	f.Fuzz(func(t *testing.T, v uint8) {
+		checkConversion(t, safe.Uint8ToUint64, v)
+	})
+}
+
+// FuzzUint8ToUintptr validates Uint8ToUintptr with random inputs.
+func FuzzUint8ToUintptr(f *testing.F) {
+	f.Add(uint8(0))
+	f.Add(uint8(1))
+	f.Add(uint8(math.MaxUint8))
+	f.Fuzz(func(t *testing.T, v uint8) {
+		checkConversion(t, safe.Uint8ToUintptr, v)
+	})
+}
+
+// FuzzUint16ToInt validates Uint16ToInt with random inputs.
+func FuzzUint16ToInt(f *testing.F) {
+	f.Add(uint16(0))
+	f.Add(uint16(1))
+	f.Add(uint16(math.MaxUint16))
+	f.Fuzz(func(t *testing.T, v uint16) {
+		checkConversion(t, safe.Uint16ToInt, v)
+	})
+}
+
+// FuzzUint16ToInt8 validates Uint16ToInt8 with random inputs.
+func FuzzUint16ToInt8(f *testing.F) {
+	f.Add(uint16(0))
+	f.Add(uint16(1))
+	f.Add(uint16(math.MaxUint16))
+	f.Add(uint16(math.MaxInt8))
+	f.Add(uint16(math.MaxInt8 + 1))
+	f.Fuzz(func(t *testing.T, v uint16) {
+		checkConversion(t, safe.Uint16ToInt8, v)
+	})
+}
+
+// FuzzUint16ToInt16 validates Uint16ToInt16 with random inputs.
+func FuzzUint16ToInt16(f *testing.F) {
+	f.Add(uint16(0))
+	f.Add(uint16(1))
+	f.Add(uint16(math.MaxUint16))
+	f.Add(uint16(math.MaxInt16))
+	f.Add(uint16(math.MaxInt16 + 1))
+	f.Fuzz(func(t *testing.T, v uint16) {
+		checkConversion(t, safe.Uint16ToInt16, v)
+	})
+}
+
+// FuzzUint16ToInt32 validates Uint16ToInt32 with random inputs.
+func FuzzUint16ToInt32(f *testing.F) {
+	f.Add(uint16(0))
+	f.Add(uint16(1))
+	f.Add(uint16(math.MaxUint16))
+	f.Fuzz(func(t *testing.T, v uint16) {
+		checkConversion(t, safe.Uint16ToInt32, v)
+	})
+}
+
+// FuzzUint16ToInt64 validates Uint16ToInt64 with random inputs.
+func FuzzUint16ToInt64(f *testing.F) {
+	f.Add(uint16(0))
+	f.Add(uint16(1))
+	f.Add(uint16(math.MaxUint16))
+	f.Fuzz(func(t *testing.T, v uint16) {
+		checkConversion(t, safe.Uint16ToInt64, v)
+	})
+}
+
+// FuzzUint16ToUint validates Uint16ToUint with random inputs.
+func FuzzUint16ToUint(f *testing.F) {
+	f.Add(uint16(0))
+	f.Add(uint16(1))
+	f.Add(uint16(math.MaxUint16))
+	f.Fuzz(func(t *testing.T, v uint16) {
+		checkConversion(t, safe.Uint16ToUint, v)
+	})
+}
+
+// FuzzUint16ToUint8 validates Uint16ToUint8 with random inputs.
+func FuzzUint16ToUint8(f *testing.F) {
+	f.Add(uint16(0))
+	f.Add(uint16(1))
+	f.Add(uint16(math.MaxUint16))
+	f.Add(uint16(math.MaxUint8))
+	f.Add(uint16(math.MaxUint8 + 1))
+	f.Fuzz(func(t *testing.T, v uint16) {
+		checkConversion(t, safe.Uint16ToUint8, v)
+	})
+}
+
+// FuzzUint16ToUint32 validates Uint16ToUint32 with random inputs.
+func FuzzUint16ToUint32(f *testing.F) {
+	f.Add(uint16(0))
+	f.Add(uint16(1))
+	f.Add(uint16(math.MaxUint16))
+	f.Fuzz(func(t *testing.T, v uint16) {
+		checkConversion(t, safe.Uint16ToUint32, v)
+	})
+}
+
+// FuzzUint16ToUint64 validates Uint16ToUint64 with random inputs.
+func FuzzUint16ToUint64(f *testing.F) {
+	f.Add(uint16(0))
+	f.Add(uint16(1))
+	f.Add(uint16(math.MaxUint16))
+	f.Fuzz(func(t *testing.T, v uint16) {
+		checkConversion(t, safe.Uint16ToUint64, v)
+	})
+}
+
+// FuzzUint16ToUintptr validates Uint16ToUintptr with random inputs.
+func FuzzUint16ToUintptr(f *testing.F) {
+	f.Add(uint16(0))
+	f.Add(uint16(1))
+	f.Add(uint16(math.MaxUint16))
+	f.Fuzz(func(t *testing.T, v uint16) {
+		checkConversion(t, safe.Uint16ToUintptr, v)
+	})
+}
+
+// FuzzUint32ToInt validates Uint32ToInt with random inputs.
+func FuzzUint32ToInt(f *testing.F) {
+	f.Add(uint32(0))
+	f.Add(uint32(1))
+	f.Add(uint32(math.MaxUint32))
+	f.Fuzz(func(t *testing.T, v uint32) {
+		checkConversion(t, safe.Uint32ToInt, v)
+	})
+}
+
+// FuzzUint32ToInt8 validates Uint32ToInt8 with random inputs.
+func FuzzUint32ToInt8(f *testing.F) {
+	f.Add(uint32(0))
+	f.Add(uint32(1))
+	f.Add(uint32(math.MaxUint32))
+	f.Add(uint32(math.MaxInt8))
+	f.Add(uint32(math.MaxInt8 + 1))
+	f.Fuzz(func(t *testing.T, v uint32) {
+		checkConversion(t, safe.Uint32ToInt8, v)
+	})
+}
+
+// FuzzUint32ToInt16 validates Uint32ToInt16 with random inputs.
+func FuzzUint32ToInt16(f *testing.F) {
+	f.Add(uint32(0))
+	f.Add(uint32(1))
+	f.Add(uint32(math.MaxUint32))
+	f.Add(uint32(math.MaxInt16))
+	f.Add(uint32(math.MaxInt16 + 1))
+	f.Fuzz(func(t *testing.T, v uint32) {
+		checkConversion(t, safe.Uint32ToInt16, v)
+	})
+}
+
+// FuzzUint32ToUint validates Uint32ToUint with random inputs.
+func FuzzUint32ToUint(f *testing.F) {
+	f.Add(uint32(0))
+	f.Add(uint32(1))
+	f.Add(uint32(math.MaxUint32))
+	f.Fuzz(func(t *testing.T, v uint32) {
+		checkConversion(t, safe.Uint32ToUint, v)
+	})
+}
+
+// FuzzUint32ToUint16 validates Uint32ToUint16 with random inputs.
+func FuzzUint32ToUint16(f *testing.F) {
+	f.Add(uint32(0))
+	f.Add(uint32(1))
+	f.Add(uint32(math.MaxUint32))
+	f.Add(uint32(math.MaxUint16))
+	f.Add(uint32(math.MaxUint16 + 1))
+	f.Fuzz(func(t *testing.T, v uint32) {
+		checkConversion(t, safe.Uint32ToUint16, v)
+	})
+}
+
+// FuzzUint32ToUintptr validates Uint32ToUintptr with random inputs.
+func FuzzUint32ToUintptr(f *testing.F) {
+	f.Add(uint32(0))
+	f.Add(uint32(1))
+	f.Add(uint32(math.MaxUint32))
+	f.Fuzz(func(t *testing.T, v uint32) {
+		checkConversion(t, safe.Uint32ToUintptr, v)
+	})
+}
+
+// FuzzUint64ToInt8 validates Uint64ToInt8 with random inputs.
+func FuzzUint64ToInt8(f *testing.F) {
+	f.Add(uint64(0))
+	f.Add(uint64(1))
+	f.Add(uint64(math.MaxUint64))
+	f.Add(uint64(math.MaxInt8))
+	f.Add(uint64(math.MaxInt8 + 1))
+	f.Fuzz(func(t *testing.T, v uint64) {
+		checkConversion(t, safe.Uint64ToInt8, v)
+	})
+}
+
+// FuzzUint64ToInt16 validates Uint64ToInt16 with random inputs.
+func FuzzUint64ToInt16(f *testing.F) {
+	f.Add(uint64(0))
+	f.Add(uint64(1))
+	f.Add(uint64(math.MaxUint64))
+	f.Add(uint64(math.MaxInt16))
+	f.Add(uint64(math.MaxInt16 + 1))
+	f.Fuzz(func(t *testing.T, v uint64) {
+		checkConversion(t, safe.Uint64ToInt16, v)
+	})
+}
+
+// FuzzUint64ToUint validates Uint64ToUint with random inputs.
+func FuzzUint64ToUint(f *testing.F) {
+	f.Add(uint64(0))
+	f.Add(uint64(1))
+	f.Add(uint64(math.MaxUint64))
+	f.Fuzz(func(t *testing.T, v uint64) {
+		checkConversion(t, safe.Uint64ToUint, v)
+	})
+}
+
+// FuzzUint64ToUint8 validates Uint64ToUint8 with random inputs.
+func FuzzUint64ToUint8(f *testing.F) {
+	f.Add(uint64(0))
+	f.Add(uint64(1))
+	f.Add(uint64(math.MaxUint64))
+	f.Add(uint64(math.MaxUint8))
+	f.Add(uint64(math.MaxUint8 + 1))
+	f.Fuzz(func(t *testing.T, v uint64) {
+		checkConversion(t, safe.Uint64ToUint8, v)
+	})
+}
+
+// FuzzUintptrToInt8 validates UintptrToInt8 with random inputs.
+func FuzzUintptrToInt8(f *testing.F) {
+	f.Add(uint64(0))
+	f.Add(uint64(1))
+	f.Add(uint64(^uintptr(0)))
+	f.Add(uint64(math.MaxInt8))
+	f.Add(uint64(math.MaxInt8 + 1))
+	f.Fuzz(func(t *testing.T, v uint64) {
+		checkConversion(t, safe.UintptrToInt8, uintptr(v))
+	})
+}
+
+// FuzzUintptrToInt16 validates UintptrToInt16 with random inputs.
+func FuzzUintptrToInt16(f *testing.F) {
+	f.Add(uint64(0))
+	f.Add(uint64(1))
+	f.Add(uint64(^uintptr(0)))
+	f.Add(uint64(math.MaxInt16))
+	f.Add(uint64(math.MaxInt16 + 1))
+	f.Fuzz(func(t *testing.T, v uint64) {
+		checkConversion(t, safe.UintptrToInt16, uintptr(v))
+	})
+}
+
+// FuzzUintptrToInt32 validates UintptrToInt32 with random inputs.
+func FuzzUintptrToInt32(f *testing.F) {
+	f.Add(uint64(0))
+	f.Add(uint64(1))
+	f.Add(uint64(^uintptr(0)))
+	f.Add(uint64(math.MaxInt32))
+	f.Add(uint64(math.MaxInt32 + 1))
+	f.Fuzz(func(t *testing.T, v uint64) {
+		checkConversion(t, safe.UintptrToInt32, uintptr(v))
+	})
+}
+
+// FuzzUintptrToInt64 validates UintptrToInt64 with random inputs.
+func FuzzUintptrToInt64(f *testing.F) {
+	f.Add(uint64(0))
+	f.Add(uint64(1))
+	f.Add(uint64(^uintptr(0)))
+	f.Fuzz(func(t *testing.T, v uint64) {
+		checkConversion(t, safe.UintptrToInt64, uintptr(v))
+	})
+}
+
+// FuzzUintptrToUint validates UintptrToUint with random inputs.
+func FuzzUintptrToUint(f *testing.F) {
+	f.Add(uint64(0))
+	f.Add(uint64(1))
+	f.Add(uint64(^uintptr(0)))
+	f.Fuzz(func(t *testing.T, v uint64) {
+		checkConversion(t, safe.UintptrToUint, uintptr(v))
+	})
+}
+
+// FuzzUintptrToUint8 validates UintptrToUint8 with random inputs.
+func FuzzUintptrToUint8(f *testing.F) {
+	f.Add(uint64(0))
+	f.Add(uint64(1))
+	f.Add(uint64(^uintptr(0)))
+	f.Add(uint64(math.MaxUint8))
+	f.Add(uint64(math.MaxUint8 + 1))
+	f.Fuzz(func(t *testing.T, v uint64) {
+		checkConversion(t, safe.UintptrToUint8, uintptr(v))
+	})
+}
+
+// FuzzUintptrToUint16 validates UintptrToUint16 with random inputs.
+func FuzzUintptrToUint16(f *testing.F) {
+	f.Add(uint64(0))
+	f.Add(uint64(1))
+	f.Add(uint64(^uintptr(0)))
+	f.Add(uint64(math.MaxUint16))
+	f.Add(uint64(math.MaxUint16 + 1))
+	f.Fuzz(func(t *testing.T, v uint64) {
+		checkConversion(t, safe.UintptrToUint16, uintptr(v))
+	})
+}
+
+// FuzzUintptrToUint32 validates UintptrToUint32 with random inputs.
+func FuzzUintptrToUint32(f *testing.F) {
+	f.Add(uint64(0))
+	f.Add(uint64(1))
+	f.Add(uint64(^uintptr(0)))
+	f.Add(uint64(math.MaxUint32))
+	f.Fuzz(func(t *testing.T, v uint64) {
+		checkConversion(t, safe.UintptrToUint32, uintptr(v))
+	})
+}
+
+// FuzzUintptrToUint64 validates UintptrToUint64 with random inputs.
+func FuzzUintptrToUint64(f *testing.F) {
+	f.Add(uint64(0))
+	f.Add(uint64(1))
+	f.Add(uint64(^uintptr(0)))
+	f.Fuzz(func(t *testing.T, v uint64) {
+		checkConversion(t, safe.UintptrToUint64, uintptr(v))
+	})
+}
+
+// generatedDifferentials returns the differential cases of the generated conversions.
+func generatedDifferentials() []differential {
+	return []differential{
+		integerDifferential("IntToInt8", safe.IntToInt8),
+		integerDifferential("IntToInt64", safe.IntToInt64),
+		integerDifferential("IntToUint", safe.IntToUint),
+		integerDifferential("IntToUint8", safe.IntToUint8),
+		integerDifferential("Int8ToInt", safe.Int8ToInt),
+		integerDifferential("Int8ToInt16", safe.Int8ToInt16),
+		integerDifferential("Int8ToInt32", safe.Int8ToInt32),
+		integerDifferential("Int8ToInt64", safe.Int8ToInt64),
+		integerDifferential("Int8ToUint", safe.Int8ToUint),
+		integerDifferential("Int8ToUint8", safe.Int8ToUint8),
+		integerDifferential("Int8ToUint16", safe.Int8ToUint16),
+		integerDifferential("Int8ToUint32", safe.Int8ToUint32),
+		integerDifferential("Int8ToUint64", safe.Int8ToUint64),
+		integerDifferential("Int8ToUintptr", safe.Int8ToUintptr),
+		integerDifferential("Int16ToInt", safe.Int16ToInt),
+		integerDifferential("Int16ToInt8", safe.Int16ToInt8),
+		integerDifferential("Int16ToInt32", safe.Int16ToInt32),
+		integerDifferential("Int16ToInt64", safe.Int16ToInt64),
+		integerDifferential("Int16ToUint", safe.Int16ToUint),
+		integerDifferential("Int16ToUint8", safe.Int16ToUint8),
+		integerDifferential("Int16ToUint16", safe.Int16ToUint16),
+		integerDifferential("Int16ToUint32", safe.Int16ToUint32),
+		integerDifferential("Int16ToUint64", safe.Int16ToUint64),
+		integerDifferential("Int16ToUintptr", safe.Int16ToUintptr),
+		integerDifferential("Int32ToInt", safe.Int32ToInt),
+		integerDifferential("Int32ToInt8", safe.Int32ToInt8),
+		integerDifferential("Int32ToInt16", safe.Int32ToInt16),
+		integerDifferential("Int32ToInt64", safe.Int32ToInt64),
+		integerDifferential("Int32ToUint", safe.Int32ToUint),
+		integerDifferential("Int32ToUint8", safe.Int32ToUint8),
+		integerDifferential("Int32ToUint16", safe.Int32ToUint16),
+		integerDifferential("Int32ToUint64", safe.Int32ToUint64),
+		integerDifferential("Int32ToUintptr", safe.Int32ToUintptr),
+		integerDifferential("Int64ToInt", safe.Int64ToInt),
+		integerDifferential("Int64ToInt8", safe.Int64ToInt8),
+		integerDifferential("Int64ToInt16", safe.Int64ToInt16),
+		integerDifferential("Int64ToUint", safe.Int64ToUint),
+		integerDifferential("Int64ToUint8", safe.Int64ToUint8),
+		integerDifferential("Int64ToUint16", safe.Int64ToUint16),
+		integerDifferential("UintToInt", safe.UintToInt),
+		integerDifferential("UintToInt8", safe.UintToInt8),
+		integerDifferential("UintToInt16", safe.UintToInt16),
+		integerDifferential("UintToInt32", safe.UintToInt32),
+		integerDifferential("UintToInt64", safe.UintToInt64),
+		integerDifferential("UintToUint8", safe.UintToUint8),
+		integerDifferential("UintToUint16", safe.UintToUint16),
+		integerDifferential("UintToUint64", safe.UintToUint64),
+		integerDifferential("UintToUintptr", safe.UintToUintptr),
+		integerDifferential("Uint8ToInt", safe.Uint8ToInt),
+		integerDifferential("Uint8ToInt8", safe.Uint8ToInt8),
+		integerDifferential("Uint8ToInt16", safe.Uint8ToInt16),
+		integerDifferential("Uint8ToInt32", safe.Uint8ToInt32),
+		integerDifferential("Uint8ToInt64", safe.Uint8ToInt64),
+		integerDifferential("Uint8ToUint", safe.Uint8ToUint),
+		integerDifferential("Uint8ToUint16", safe.Uint8ToUint16),
+		integerDifferential("Uint8ToUint32", safe.Uint8ToUint32),
+		integerDifferential("Uint8ToUint64", safe.Uint8ToUint64),
+		integerDifferential("Uint8ToUintptr", safe.Uint8ToUintptr),
+		integerDifferential("Uint16ToInt", safe.Uint16ToInt),
+		integerDifferential("Uint16ToInt8", safe.Uint16ToInt8),
+		integerDifferential("Uint16ToInt16", safe.Uint16ToInt16),
+		integerDifferential("Uint16ToInt32", safe.Uint16ToInt32),
+		integerDifferential("Uint16ToInt64", safe.Uint16ToInt64),
+		integerDifferential("Uint16ToUint", safe.Uint16ToUint),
+		integerDifferential("Uint16ToUint8", safe.Uint16ToUint8),
+		integerDifferential("Uint16ToUint32", safe.Uint16ToUint32),
+		integerDifferential("Uint16ToUint64", safe.Uint16ToUint64),
+		integerDifferential("Uint16ToUintptr", safe.Uint16ToUintptr),
+		integerDifferential("Uint32ToInt", safe.Uint32ToInt),
+		integerDifferential("Uint32ToInt8", safe.Uint32ToInt8),
+		integerDifferential("Uint32ToInt16", safe.Uint32ToInt16),
+		integerDifferential("Uint32ToUint", safe.Uint32ToUint),
+		integerDifferential("Uint32ToUint16", safe.Uint32ToUint16),
+		integerDifferential("Uint32ToUintptr", safe.Uint32ToUintptr),
+		integerDifferential("Uint64ToInt8", safe.Uint64ToInt8),
+		integerDifferential("Uint64ToInt16", safe.Uint64ToInt16),
+		integerDifferential("Uint64ToUint", safe.Uint64ToUint),
+		integerDifferential("Uint64ToUint8", safe.Uint64ToUint8),
+		integerDifferential("UintptrToInt8", safe.UintptrToInt8),
+		integerDifferential("UintptrToInt16", safe.UintptrToInt16),
+		integerDifferential("UintptrToInt32", safe.UintptrToInt32),
+		integerDifferential("UintptrToInt64", safe.UintptrToInt64),
+		integerDifferential("UintptrToUint", safe.UintptrToUint),
+		integerDifferential("UintptrToUint8", safe.UintptrToUint8),
+		integerDifferential("UintptrToUint16", safe.UintptrToUint16),
+		integerDifferential("UintptrToUint32", safe.UintptrToUint32),
+		integerDifferential("UintptrToUint64", safe.UintptrToUint64),
+	}
+}
+
+// BenchmarkIntToInt8 benchmarks the performance of IntToInt8.
+func BenchmarkIntToInt8(b *testing.B) {
+	var r int8
+	var err error
+	v := int(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.IntToInt8(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkIntToInt64 benchmarks the performance of IntToInt64.
+func BenchmarkIntToInt64(b *testing.B) {
+	var r int64
+	var err error
+	v := int(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.IntToInt64(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkIntToUint benchmarks the performance of IntToUint.
+func BenchmarkIntToUint(b *testing.B) {
+	var r uint
+	var err error
+	v := int(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.IntToUint(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkIntToUint8 benchmarks the performance of IntToUint8.
+func BenchmarkIntToUint8(b *testing.B) {
+	var r uint8
+	var err error
+	v := int(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.IntToUint8(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkInt8ToInt benchmarks the performance of Int8ToInt.
+func BenchmarkInt8ToInt(b *testing.B) {
+	var r int
+	var err error
+	v := int8(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Int8ToInt(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkInt8ToInt16 benchmarks the performance of Int8ToInt16.
+func BenchmarkInt8ToInt16(b *testing.B) {
+	var r int16
+	var err error
+	v := int8(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Int8ToInt16(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkInt8ToInt32 benchmarks the performance of Int8ToInt32.
+func BenchmarkInt8ToInt32(b *testing.B) {
+	var r int32
+	var err error
+	v := int8(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Int8ToInt32(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkInt8ToInt64 benchmarks the performance of Int8ToInt64.
+func BenchmarkInt8ToInt64(b *testing.B) {
+	var r int64
+	var err error
+	v := int8(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Int8ToInt64(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkInt8ToUint benchmarks the performance of Int8ToUint.
+func BenchmarkInt8ToUint(b *testing.B) {
+	var r uint
+	var err error
+	v := int8(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Int8ToUint(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkInt8ToUint8 benchmarks the performance of Int8ToUint8.
+func BenchmarkInt8ToUint8(b *testing.B) {
+	var r uint8
+	var err error
+	v := int8(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Int8ToUint8(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkInt8ToUint16 benchmarks the performance of Int8ToUint16.
+func BenchmarkInt8ToUint16(b *testing.B) {
+	var r uint16
+	var err error
+	v := int8(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Int8ToUint16(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkInt8ToUint32 benchmarks the performance of Int8ToUint32.
+func BenchmarkInt8ToUint32(b *testing.B) {
+	var r uint32
+	var err error
+	v := int8(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Int8ToUint32(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkInt8ToUint64 benchmarks the performance of Int8ToUint64.
+func BenchmarkInt8ToUint64(b *testing.B) {
+	var r uint64
+	var err error
+	v := int8(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Int8ToUint64(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkInt8ToUintptr benchmarks the performance of Int8ToUintptr.
+func BenchmarkInt8ToUintptr(b *testing.B) {
+	var r uintptr
+	var err error
+	v := int8(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Int8ToUintptr(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkInt16ToInt benchmarks the performance of Int16ToInt.
+func BenchmarkInt16ToInt(b *testing.B) {
+	var r int
+	var err error
+	v := int16(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Int16ToInt(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkInt16ToInt8 benchmarks the performance of Int16ToInt8.
+func BenchmarkInt16ToInt8(b *testing.B) {
+	var r int8
+	var err error
+	v := int16(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Int16ToInt8(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkInt16ToInt32 benchmarks the performance of Int16ToInt32.
+func BenchmarkInt16ToInt32(b *testing.B) {
+	var r int32
+	var err error
+	v := int16(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Int16ToInt32(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkInt16ToInt64 benchmarks the performance of Int16ToInt64.
+func BenchmarkInt16ToInt64(b *testing.B) {
+	var r int64
+	var err error
+	v := int16(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Int16ToInt64(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkInt16ToUint benchmarks the performance of Int16ToUint.
+func BenchmarkInt16ToUint(b *testing.B) {
+	var r uint
+	var err error
+	v := int16(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Int16ToUint(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkInt16ToUint8 benchmarks the performance of Int16ToUint8.
+func BenchmarkInt16ToUint8(b *testing.B) {
+	var r uint8
+	var err error
+	v := int16(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Int16ToUint8(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkInt16ToUint16 benchmarks the performance of Int16ToUint16.
+func BenchmarkInt16ToUint16(b *testing.B) {
+	var r uint16
+	var err error
+	v := int16(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Int16ToUint16(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkInt16ToUint32 benchmarks the performance of Int16ToUint32.
+func BenchmarkInt16ToUint32(b *testing.B) {
+	var r uint32
+	var err error
+	v := int16(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Int16ToUint32(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkInt16ToUint64 benchmarks the performance of Int16ToUint64.
+func BenchmarkInt16ToUint64(b *testing.B) {
+	var r uint64
+	var err error
+	v := int16(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Int16ToUint64(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkInt16ToUintptr benchmarks the performance of Int16ToUintptr.
+func BenchmarkInt16ToUintptr(b *testing.B) {
+	var r uintptr
+	var err error
+	v := int16(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Int16ToUintptr(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkInt32ToInt benchmarks the performance of Int32ToInt.
+func BenchmarkInt32ToInt(b *testing.B) {
+	var r int
+	var err error
+	v := int32(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Int32ToInt(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkInt32ToInt8 benchmarks the performance of Int32ToInt8.
+func BenchmarkInt32ToInt8(b *testing.B) {
+	var r int8
+	var err error
+	v := int32(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Int32ToInt8(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkInt32ToInt16 benchmarks the performance of Int32ToInt16.
+func BenchmarkInt32ToInt16(b *testing.B) {
+	var r int16
+	var err error
+	v := int32(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Int32ToInt16(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkInt32ToInt64 benchmarks the performance of Int32ToInt64.
+func BenchmarkInt32ToInt64(b *testing.B) {
+	var r int64
+	var err error
+	v := int32(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Int32ToInt64(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkInt32ToUint benchmarks the performance of Int32ToUint.
+func BenchmarkInt32ToUint(b *testing.B) {
+	var r uint
+	var err error
+	v := int32(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Int32ToUint(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkInt32ToUint8 benchmarks the performance of Int32ToUint8.
+func BenchmarkInt32ToUint8(b *testing.B) {
+	var r uint8
+	var err error
+	v := int32(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Int32ToUint8(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkInt32ToUint16 benchmarks the performance of Int32ToUint16.
+func BenchmarkInt32ToUint16(b *testing.B) {
+	var r uint16
+	var err error
+	v := int32(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Int32ToUint16(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkInt32ToUint64 benchmarks the performance of Int32ToUint64.
+func BenchmarkInt32ToUint64(b *testing.B) {
+	var r uint64
+	var err error
+	v := int32(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Int32ToUint64(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkInt32ToUintptr benchmarks the performance of Int32ToUintptr.
+func BenchmarkInt32ToUintptr(b *testing.B) {
+	var r uintptr
+	var err error
+	v := int32(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Int32ToUintptr(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkInt64ToInt benchmarks the performance of Int64ToInt.
+func BenchmarkInt64ToInt(b *testing.B) {
+	var r int
+	var err error
+	v := int64(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Int64ToInt(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkInt64ToInt8 benchmarks the performance of Int64ToInt8.
+func BenchmarkInt64ToInt8(b *testing.B) {
+	var r int8
+	var err error
+	v := int64(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Int64ToInt8(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkInt64ToInt16 benchmarks the performance of Int64ToInt16.
+func BenchmarkInt64ToInt16(b *testing.B) {
+	var r int16
+	var err error
+	v := int64(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Int64ToInt16(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkInt64ToUint benchmarks the performance of Int64ToUint.
+func BenchmarkInt64ToUint(b *testing.B) {
+	var r uint
+	var err error
+	v := int64(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Int64ToUint(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkInt64ToUint8 benchmarks the performance of Int64ToUint8.
+func BenchmarkInt64ToUint8(b *testing.B) {
+	var r uint8
+	var err error
+	v := int64(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Int64ToUint8(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkInt64ToUint16 benchmarks the performance of Int64ToUint16.
+func BenchmarkInt64ToUint16(b *testing.B) {
+	var r uint16
+	var err error
+	v := int64(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Int64ToUint16(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkUintToInt benchmarks the performance of UintToInt.
+func BenchmarkUintToInt(b *testing.B) {
+	var r int
+	var err error
+	v := uint(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.UintToInt(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkUintToInt8 benchmarks the performance of UintToInt8.
+func BenchmarkUintToInt8(b *testing.B) {
+	var r int8
+	var err error
+	v := uint(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.UintToInt8(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkUintToInt16 benchmarks the performance of UintToInt16.
+func BenchmarkUintToInt16(b *testing.B) {
+	var r int16
+	var err error
+	v := uint(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.UintToInt16(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkUintToInt32 benchmarks the performance of UintToInt32.
+func BenchmarkUintToInt32(b *testing.B) {
+	var r int32
+	var err error
+	v := uint(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.UintToInt32(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkUintToInt64 benchmarks the performance of UintToInt64.
+func BenchmarkUintToInt64(b *testing.B) {
+	var r int64
+	var err error
+	v := uint(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.UintToInt64(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkUintToUint8 benchmarks the performance of UintToUint8.
+func BenchmarkUintToUint8(b *testing.B) {
+	var r uint8
+	var err error
+	v := uint(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.UintToUint8(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkUintToUint16 benchmarks the performance of UintToUint16.
+func BenchmarkUintToUint16(b *testing.B) {
+	var r uint16
+	var err error
+	v := uint(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.UintToUint16(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkUintToUint64 benchmarks the performance of UintToUint64.
+func BenchmarkUintToUint64(b *testing.B) {
+	var r uint64
+	var err error
+	v := uint(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.UintToUint64(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkUintToUintptr benchmarks the performance of UintToUintptr.
+func BenchmarkUintToUintptr(b *testing.B) {
+	var r uintptr
+	var err error
+	v := uint(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.UintToUintptr(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkUint8ToInt benchmarks the performance of Uint8ToInt.
+func BenchmarkUint8ToInt(b *testing.B) {
+	var r int
+	var err error
+	v := uint8(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Uint8ToInt(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkUint8ToInt8 benchmarks the performance of Uint8ToInt8.
+func BenchmarkUint8ToInt8(b *testing.B) {
+	var r int8
+	var err error
+	v := uint8(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Uint8ToInt8(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkUint8ToInt16 benchmarks the performance of Uint8ToInt16.
+func BenchmarkUint8ToInt16(b *testing.B) {
+	var r int16
+	var err error
+	v := uint8(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Uint8ToInt16(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkUint8ToInt32 benchmarks the performance of Uint8ToInt32.
+func BenchmarkUint8ToInt32(b *testing.B) {
+	var r int32
+	var err error
+	v := uint8(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Uint8ToInt32(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkUint8ToInt64 benchmarks the performance of Uint8ToInt64.
+func BenchmarkUint8ToInt64(b *testing.B) {
+	var r int64
+	var err error
+	v := uint8(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Uint8ToInt64(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkUint8ToUint benchmarks the performance of Uint8ToUint.
+func BenchmarkUint8ToUint(b *testing.B) {
+	var r uint
+	var err error
+	v := uint8(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Uint8ToUint(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkUint8ToUint16 benchmarks the performance of Uint8ToUint16.
+func BenchmarkUint8ToUint16(b *testing.B) {
+	var r uint16
+	var err error
+	v := uint8(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Uint8ToUint16(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkUint8ToUint32 benchmarks the performance of Uint8ToUint32.
+func BenchmarkUint8ToUint32(b *testing.B) {
+	var r uint32
+	var err error
+	v := uint8(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Uint8ToUint32(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkUint8ToUint64 benchmarks the performance of Uint8ToUint64.
+func BenchmarkUint8ToUint64(b *testing.B) {
+	var r uint64
+	var err error
+	v := uint8(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Uint8ToUint64(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkUint8ToUintptr benchmarks the performance of Uint8ToUintptr.
+func BenchmarkUint8ToUintptr(b *testing.B) {
+	var r uintptr
+	var err error
+	v := uint8(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Uint8ToUintptr(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkUint16ToInt benchmarks the performance of Uint16ToInt.
+func BenchmarkUint16ToInt(b *testing.B) {
+	var r int
+	var err error
+	v := uint16(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Uint16ToInt(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkUint16ToInt8 benchmarks the performance of Uint16ToInt8.
+func BenchmarkUint16ToInt8(b *testing.B) {
+	var r int8
+	var err error
+	v := uint16(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Uint16ToInt8(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkUint16ToInt16 benchmarks the performance of Uint16ToInt16.
+func BenchmarkUint16ToInt16(b *testing.B) {
+	var r int16
+	var err error
+	v := uint16(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Uint16ToInt16(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkUint16ToInt32 benchmarks the performance of Uint16ToInt32.
+func BenchmarkUint16ToInt32(b *testing.B) {
+	var r int32
+	var err error
+	v := uint16(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Uint16ToInt32(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkUint16ToInt64 benchmarks the performance of Uint16ToInt64.
+func BenchmarkUint16ToInt64(b *testing.B) {
+	var r int64
+	var err error
+	v := uint16(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Uint16ToInt64(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkUint16ToUint benchmarks the performance of Uint16ToUint.
+func BenchmarkUint16ToUint(b *testing.B) {
+	var r uint
+	var err error
+	v := uint16(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Uint16ToUint(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkUint16ToUint8 benchmarks the performance of Uint16ToUint8.
+func BenchmarkUint16ToUint8(b *testing.B) {
+	var r uint8
+	var err error
+	v := uint16(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Uint16ToUint8(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkUint16ToUint32 benchmarks the performance of Uint16ToUint32.
+func BenchmarkUint16ToUint32(b *testing.B) {
+	var r uint32
+	var err error
+	v := uint16(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Uint16ToUint32(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkUint16ToUint64 benchmarks the performance of Uint16ToUint64.
+func BenchmarkUint16ToUint64(b *testing.B) {
+	var r uint64
+	var err error
+	v := uint16(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Uint16ToUint64(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkUint16ToUintptr benchmarks the performance of Uint16ToUintptr.
+func BenchmarkUint16ToUintptr(b *testing.B) {
+	var r uintptr
+	var err error
+	v := uint16(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Uint16ToUintptr(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkUint32ToInt benchmarks the performance of Uint32ToInt.
+func BenchmarkUint32ToInt(b *testing.B) {
+	var r int
+	var err error
+	v := uint32(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Uint32ToInt(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkUint32ToInt8 benchmarks the performance of Uint32ToInt8.
+func BenchmarkUint32ToInt8(b *testing.B) {
+	var r int8
+	var err error
+	v := uint32(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Uint32ToInt8(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkUint32ToInt16 benchmarks the performance of Uint32ToInt16.
+func BenchmarkUint32ToInt16(b *testing.B) {
+	var r int16
+	var err error
+	v := uint32(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Uint32ToInt16(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkUint32ToUint benchmarks the performance of Uint32ToUint.
+func BenchmarkUint32ToUint(b *testing.B) {
+	var r uint
+	var err error
+	v := uint32(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Uint32ToUint(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkUint32ToUint16 benchmarks the performance of Uint32ToUint16.
+func BenchmarkUint32ToUint16(b *testing.B) {
+	var r uint16
+	var err error
+	v := uint32(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Uint32ToUint16(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkUint32ToUintptr benchmarks the performance of Uint32ToUintptr.
+func BenchmarkUint32ToUintptr(b *testing.B) {
+	var r uintptr
+	var err error
+	v := uint32(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Uint32ToUintptr(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkUint64ToInt8 benchmarks the performance of Uint64ToInt8.
+func BenchmarkUint64ToInt8(b *testing.B) {
+	var r int8
+	var err error
+	v := uint64(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Uint64ToInt8(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkUint64ToInt16 benchmarks the performance of Uint64ToInt16.
+func BenchmarkUint64ToInt16(b *testing.B) {
+	var r int16
+	var err error
+	v := uint64(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Uint64ToInt16(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkUint64ToUint benchmarks the performance of Uint64ToUint.
+func BenchmarkUint64ToUint(b *testing.B) {
+	var r uint
+	var err error
+	v := uint64(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Uint64ToUint(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkUint64ToUint8 benchmarks the performance of Uint64ToUint8.
+func BenchmarkUint64ToUint8(b *testing.B) {
+	var r uint8
+	var err error
+	v := uint64(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Uint64ToUint8(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkUintptrToInt8 benchmarks the performance of UintptrToInt8.
+func BenchmarkUintptrToInt8(b *testing.B) {
+	var r int8
+	var err error
+	v := uintptr(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.UintptrToInt8(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkUintptrToInt16 benchmarks the performance of UintptrToInt16.
+func BenchmarkUintptrToInt16(b *testing.B) {
+	var r int16
+	var err error
+	v := uintptr(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.UintptrToInt16(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkUintptrToInt32 benchmarks the performance of UintptrToInt32.
+func BenchmarkUintptrToInt32(b *testing.B) {
+	var r int32
+	var err error
+	v := uintptr(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.UintptrToInt32(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkUintptrToInt64 benchmarks the performance of UintptrToInt64.
+func BenchmarkUintptrToInt64(b *testing.B) {
+	var r int64
+	var err error
+	v := uintptr(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.UintptrToInt64(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkUintptrToUint benchmarks the performance of UintptrToUint.
+func BenchmarkUintptrToUint(b *testing.B) {
+	var r uint
+	var err error
+	v := uintptr(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.UintptrToUint(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkUintptrToUint8 benchmarks the performance of UintptrToUint8.
+func BenchmarkUintptrToUint8(b *testing.B) {
+	var r uint8
+	var err error
+	v := uintptr(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.UintptrToUint8(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkUintptrToUint16 benchmarks the performance of UintptrToUint16.
+func BenchmarkUintptrToUint16(b *testing.B) {
+	var r uint16
+	var err error
+	v := uintptr(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.UintptrToUint16(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkUintptrToUint32 benchmarks the performance of UintptrToUint32.
+func BenchmarkUintptrToUint32(b *testing.B) {
+	var r uint32
+	var err error
+	v := uintptr(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.UintptrToUint32(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkUintptrToUint64 benchmarks the performance of UintptrToUint64.
+func BenchmarkUintptrToUint64(b *testing.B) {
+	var r uint64
+	var err error
+	v := uintptr(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.UintptrToUint64(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
@@ -0,0 +1,33 @@
+package safeconversion_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	safe "github.com/bsv-blockchain/go-safe-conversion"
+	"github.com/bsv-blockchain/go-safe-conversion/safeconversiontest"
+)
+
+// checkConversion checks fn against the math/big oracle of safeconversiontest, and checks
+// that a failed conversion returns zero and a range error.
+func checkConversion[S, D safe.Integer](t *testing.T, fn func(S) (D, error), value S) {
+	t.Helper()
+
+	safeconversiontest.CheckValue(t, fn, value)
+
+	result, err := fn(value)
+	if err == nil {
+		return
+	}
+
+	assert.Zero(t, result)
+
+	var zero D
+	if value < 0 && zero-1 > zero {
+		require.ErrorIs(t, err, safe.ErrNegativeValueCannotBeConverted)
+	} else {
+		require.ErrorIs(t, err, safe.ErrValueOutOfRange)
+	}
+}
@@ -0,0 +1,90 @@
+package safeconversion
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+// CheckedCounter is an integer counter that can be updated from many goroutines. Updates are
+// applied with compare-and-swap loops on a sync/atomic value and are checked, so the counter
+// never wraps around: it always holds a value between zero and the maximum value of T.
+// The zero value is a counter holding zero. A CheckedCounter must not be copied after first use.
+type CheckedCounter[T Integer] struct {
+	// bits holds the value of T; since the value is never negative, conversions are exact.
+	bits atomic.Uint64
+}
+
+// Fixed-width CheckedCounter types matching the integer types of sync/atomic.
+type (
+	CheckedCounterInt32  = CheckedCounter[int32]
+	CheckedCounterInt64  = CheckedCounter[int64]
+	CheckedCounterUint32 = CheckedCounter[uint32]
+	CheckedCounterUint64 = CheckedCounter[uint64]
+)
+
+// Load returns the current value of the counter.
+func (c *CheckedCounter[T]) Load() T {
+	return T(c.bits.Load()) //nolint:gosec // bits always holds a value of T
+}
+
+// Store sets the counter to value.
+// Returns an error if the value is negative, leaving the counter unchanged.
+func (c *CheckedCounter[T]) Store(value T) error {
+	if value < 0 {
+		return fmt.Errorf("%w to %s counter: %d", ErrNegativeValueCannotBeConverted, typeName[T](), value)
+	}
+
+	c.bits.Store(uint64(value))
+
+	return nil
+}
+
+// Add adds delta to the counter and returns the new value.
+// Returns ErrValueOverflow if the result exceeds the maximum value of T, or
+// ErrNegativeValueCannotBeConverted if it is negative, leaving the counter unchanged.
+func (c *CheckedCounter[T]) Add(delta T) (T, error) {
+	return c.update(func(old T) (T, error) {
+		switch {
+		case delta > 0 && old > maxOf[T]()-delta:
+			return 0, fmt.Errorf("%s counter %w: %d + %d", typeName[T](), ErrValueOverflow, old, delta)
+		case delta < 0 && delta < -old:
+			return 0, fmt.Errorf("%w to %s counter: %d + %d", ErrNegativeValueCannotBeConverted, typeName[T](), old, delta)
+		default:
+			return old + delta, nil
+		}
+	})
+}
+
+// Sub subtracts delta from the counter and returns the new value.
+// Returns ErrNegativeValueCannotBeConverted if the result is negative, or ErrValueOverflow
+// if it exceeds the maximum value of T, leaving the counter unchanged.
+func (c *CheckedCounter[T]) Sub(delta T) (T, error) {
+	return c.update(func(old T) (T, error) {
+		switch {
+		case delta > old:
+			return 0, fmt.Errorf("%w to %s counter: %d - %d", ErrNegativeValueCannotBeConverted, typeName[T](), old, delta)
+		case delta < 0 && old > maxOf[T]()+delta:
+			return 0, fmt.Errorf("%s counter %w: %d - %d", typeName[T](), ErrValueOverflow, old, delta)
+		default:
+			return old - delta, nil
+		}
+	})
+}
+
+// update replaces the current value with the result of next, retrying until no other goroutine
+// has changed the counter in between, and returns the new value.
+func (c *CheckedCounter[T]) update(next func(old T) (T, error)) (T, error) {
+	for {
+		oldBits := c.bits.Load()
+		old := T(oldBits) //nolint:gosec // bits always holds a value of T
+
+		value, err := next(old)
+		if err != nil {
+			return 0, err
+		}
+
+		if c.bits.CompareAndSwap(oldBits, uint64(value)) {
+			return value, nil
+		}
+	}
+}
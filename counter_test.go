@@ -0,0 +1,177 @@
+package safeconversion_test
+
+import (
+	"math"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	safe "github.com/bsv-blockchain/go-safe-conversion"
+)
+
+// TestCheckedCounterAdd tests adding to counters of signed and unsigned types.
+func TestCheckedCounterAdd(t *testing.T) {
+	tests := []struct {
+		name    string
+		start   int8
+		delta   int8
+		expect  int8
+		wantErr error
+	}{
+		{zeroValueName, 0, 0, 0, nil},
+		{positiveValueName, 1, 100, 101, nil},
+		{"up to max", 27, 100, math.MaxInt8, nil},
+		{"above max", 28, 100, 0, safe.ErrValueOverflow},
+		{"down to zero", 5, -5, 0, nil},
+		{"below zero", 5, -6, 0, safe.ErrNegativeValueCannotBeConverted},
+		{"min delta", 0, math.MinInt8, 0, safe.ErrNegativeValueCannotBeConverted},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var c safe.CheckedCounter[int8]
+			require.NoError(t, c.Store(tt.start))
+
+			result, err := c.Add(tt.delta)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Zero(t, result)
+				assert.Equal(t, tt.start, c.Load(), "a failed update leaves the counter unchanged")
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expect, result)
+			assert.Equal(t, tt.expect, c.Load())
+		})
+	}
+}
+
+// TestCheckedCounterSub tests subtracting from counters of signed and unsigned types.
+func TestCheckedCounterSub(t *testing.T) {
+	var u safe.CheckedCounterUint32
+	require.NoError(t, u.Store(10))
+
+	result, err := u.Sub(10)
+	require.NoError(t, err)
+	assert.Zero(t, result)
+
+	_, err = u.Sub(1)
+	require.ErrorIs(t, err, safe.ErrNegativeValueCannotBeConverted)
+
+	var s safe.CheckedCounterInt64
+	require.NoError(t, s.Store(math.MaxInt64-1))
+
+	_, err = s.Sub(-2)
+	require.ErrorIs(t, err, safe.ErrValueOverflow)
+
+	result64, err := s.Sub(-1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(math.MaxInt64), result64)
+
+	_, err = s.Sub(math.MinInt64)
+	require.ErrorIs(t, err, safe.ErrValueOverflow)
+}
+
+// TestCheckedCounterStore tests that negative values cannot be stored.
+func TestCheckedCounterStore(t *testing.T) {
+	var c safe.CheckedCounterInt32
+	require.ErrorIs(t, c.Store(-1), safe.ErrNegativeValueCannotBeConverted)
+	assert.Zero(t, c.Load())
+
+	var u safe.CheckedCounterUint64
+	require.NoError(t, u.Store(math.MaxUint64))
+	assert.Equal(t, uint64(math.MaxUint64), u.Load())
+}
+
+// TestCheckedCounterConcurrentAdd tests that concurrent increments are neither lost nor
+// allowed past the maximum. Run with -race to check the counter for data races.
+func TestCheckedCounterConcurrentAdd(t *testing.T) {
+	const goroutines = 1000
+
+	var (
+		c         safe.CheckedCounter[uint8]
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		succeeded int
+		overflows int
+	)
+
+	for range goroutines {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			_, err := c.Add(1)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				assert.ErrorIs(t, err, safe.ErrValueOverflow)
+				overflows++
+				return
+			}
+			succeeded++
+		}()
+	}
+
+	wg.Wait()
+
+	assert.Equal(t, math.MaxUint8, succeeded)
+	assert.Equal(t, goroutines-math.MaxUint8, overflows)
+	assert.Equal(t, uint8(math.MaxUint8), c.Load())
+}
+
+// TestCheckedCounterConcurrentAddSub tests that concurrent increments and decrements never
+// take the counter below zero. Run with -race to check the counter for data races.
+func TestCheckedCounterConcurrentAddSub(t *testing.T) {
+	const goroutines = 100
+
+	var (
+		c  safe.CheckedCounterInt64
+		wg sync.WaitGroup
+	)
+
+	require.NoError(t, c.Store(goroutines))
+
+	for range goroutines {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				_, _ = c.Add(3)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				_, err := c.Sub(3)
+				if err != nil {
+					assert.ErrorIs(t, err, safe.ErrNegativeValueCannotBeConverted)
+				}
+				assert.GreaterOrEqual(t, c.Load(), int64(0))
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	assert.GreaterOrEqual(t, c.Load(), int64(0))
+	assert.Equal(t, int64(goroutines%3), c.Load()%3, "every successful update moves the counter by 3")
+}
+
+// BenchmarkCheckedCounterAdd benchmarks contended increments of a CheckedCounter.
+func BenchmarkCheckedCounterAdd(b *testing.B) {
+	var c safe.CheckedCounterUint64
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if _, err := c.Add(1); err != nil {
+				b.Error(err)
+				return
+			}
+		}
+	})
+}
@@ -0,0 +1,299 @@
+package safeconversion
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+	"strconv"
+	"strings"
+)
+
+// MaxDecimalScale defines the largest scale a Decimal can have, as 10^18 is the largest power of ten that fits in an int64.
+const MaxDecimalScale = 18
+
+// Decimal is a fixed-point decimal number with an int64 mantissa and an implied scale,
+// representing the value Mantissa / 10^Scale. For example, 12.345 sat/byte is
+// Decimal{Mantissa: 12345, Scale: 3}. All arithmetic is checked and fails with
+// ErrValueOverflow instead of silently wrapping.
+type Decimal struct {
+	Mantissa int64
+	Scale    uint8
+}
+
+// pow10 holds the powers of ten that fit in an uint64.
+var pow10 = [...]uint64{ //nolint:gochecknoglobals // read-only lookup table
+	1, 10, 100, 1_000, 10_000, 100_000, 1_000_000, 10_000_000, 100_000_000, 1_000_000_000,
+	10_000_000_000, 100_000_000_000, 1_000_000_000_000, 10_000_000_000_000, 100_000_000_000_000,
+	1_000_000_000_000_000, 10_000_000_000_000_000, 100_000_000_000_000_000,
+	1_000_000_000_000_000_000, 10_000_000_000_000_000_000,
+}
+
+// NewDecimal returns a Decimal with the given mantissa and scale.
+// Returns an error if the scale exceeds MaxDecimalScale.
+func NewDecimal(mantissa int64, scale uint8) (Decimal, error) {
+	if scale > MaxDecimalScale {
+		return Decimal{}, fmt.Errorf("%w (decimal scale): %d (max %d)", ErrValueOutOfRange, scale, MaxDecimalScale)
+	}
+
+	return Decimal{Mantissa: mantissa, Scale: scale}, nil
+}
+
+// ParseDecimal parses a decimal string such as "-12.345" into a Decimal whose scale is the
+// number of digits after the decimal point.
+// Returns an error if the string is malformed, has more than MaxDecimalScale fractional
+// digits, or the mantissa overflows an int64.
+func ParseDecimal(s string) (Decimal, error) {
+	digits := s
+	neg := false
+
+	if len(digits) > 0 && (digits[0] == '-' || digits[0] == '+') {
+		neg = digits[0] == '-'
+		digits = digits[1:]
+	}
+
+	intPart, fracPart, hasPoint := strings.Cut(digits, ".")
+	if len(intPart)+len(fracPart) == 0 || (hasPoint && len(fracPart) == 0) {
+		return Decimal{}, fmt.Errorf("%w (decimal): %q", ErrInvalidSyntax, s)
+	}
+
+	if len(fracPart) > MaxDecimalScale {
+		return Decimal{}, fmt.Errorf("%w (decimal scale): %d (max %d)", ErrValueOutOfRange, len(fracPart), MaxDecimalScale)
+	}
+
+	var magnitude uint64
+
+	for _, c := range intPart + fracPart {
+		if c < '0' || c > '9' {
+			return Decimal{}, fmt.Errorf("%w (decimal): %q", ErrInvalidSyntax, s)
+		}
+
+		hi, lo := bits.Mul64(magnitude, 10)
+		sum, carry := bits.Add64(lo, uint64(c-'0'), 0)
+		if hi != 0 || carry != 0 {
+			return Decimal{}, fmt.Errorf("decimal %w: %s", ErrValueOverflow, s)
+		}
+
+		magnitude = sum
+	}
+
+	mantissa, err := joinSign(neg, magnitude)
+	if err != nil {
+		return Decimal{}, fmt.Errorf("decimal %w: %s", ErrValueOverflow, s)
+	}
+
+	return Decimal{Mantissa: mantissa, Scale: uint8(len(fracPart))}, nil
+}
+
+// String formats the decimal with exactly Scale digits after the decimal point.
+func (d Decimal) String() string {
+	neg, magnitude := splitSign(d.Mantissa)
+	digits := strconv.FormatUint(magnitude, 10)
+
+	scale := int(d.Scale)
+	if len(digits) <= scale {
+		digits = strings.Repeat("0", scale-len(digits)+1) + digits
+	}
+
+	if scale > 0 {
+		digits = digits[:len(digits)-scale] + "." + digits[len(digits)-scale:]
+	}
+
+	if neg {
+		return "-" + digits
+	}
+
+	return digits
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (d Decimal) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (d *Decimal) UnmarshalText(text []byte) error {
+	parsed, err := ParseDecimal(string(text))
+	if err != nil {
+		return err
+	}
+
+	*d = parsed
+
+	return nil
+}
+
+// Rescale returns the decimal converted to the given scale, rounding according to mode when
+// digits are dropped.
+// Returns an error if the scale exceeds MaxDecimalScale or the mantissa overflows an int64.
+func (d Decimal) Rescale(scale uint8, mode RoundingMode) (Decimal, error) {
+	if scale > MaxDecimalScale {
+		return Decimal{}, fmt.Errorf("%w (decimal scale): %d (max %d)", ErrValueOutOfRange, scale, MaxDecimalScale)
+	}
+
+	var num, den uint64 = 1, 1
+
+	var err error
+	if scale >= d.Scale {
+		num, err = pow10Of(scale - d.Scale)
+	} else {
+		den, err = pow10Of(d.Scale - scale)
+	}
+
+	if err != nil {
+		return Decimal{}, err
+	}
+
+	mantissa, err := mulDivSigned(d.Mantissa, num, den, false, mode)
+	if err != nil {
+		return Decimal{}, err
+	}
+
+	return Decimal{Mantissa: mantissa, Scale: scale}, nil
+}
+
+// Add returns d + o at the larger of the two scales.
+// The operands are aligned through 128-bit intermediates, so only the final result can overflow.
+// Returns an error if the result overflows.
+func (d Decimal) Add(o Decimal) (Decimal, error) {
+	return d.combine(o, Int128.Add, "+")
+}
+
+// Sub returns d - o at the larger of the two scales.
+// The operands are aligned through 128-bit intermediates, so only the final result can overflow.
+// Returns an error if the result overflows.
+func (d Decimal) Sub(o Decimal) (Decimal, error) {
+	return d.combine(o, Int128.Sub, "-")
+}
+
+// Mul returns d * o at the scale of d, rounding according to mode.
+// The product is computed through a 128-bit intermediate, so only the final result can overflow.
+func (d Decimal) Mul(o Decimal, mode RoundingMode) (Decimal, error) {
+	den, err := pow10Of(o.Scale)
+	if err != nil {
+		return Decimal{}, err
+	}
+
+	neg, magnitude := splitSign(o.Mantissa)
+
+	mantissa, err := mulDivSigned(d.Mantissa, magnitude, den, neg, mode)
+	if err != nil {
+		return Decimal{}, err
+	}
+
+	return Decimal{Mantissa: mantissa, Scale: d.Scale}, nil
+}
+
+// Div returns d / o at the scale of d, rounding according to mode.
+// Returns ErrDivisionByZero if o is zero, or an error if the result overflows.
+func (d Decimal) Div(o Decimal, mode RoundingMode) (Decimal, error) {
+	if o.Mantissa == 0 {
+		return Decimal{}, fmt.Errorf("decimal %w: %s / %s", ErrDivisionByZero, d, o)
+	}
+
+	num, err := pow10Of(o.Scale)
+	if err != nil {
+		return Decimal{}, err
+	}
+
+	neg, magnitude := splitSign(o.Mantissa)
+
+	mantissa, err := mulDivSigned(d.Mantissa, num, magnitude, neg, mode)
+	if err != nil {
+		return Decimal{}, err
+	}
+
+	return Decimal{Mantissa: mantissa, Scale: d.Scale}, nil
+}
+
+// Cmp compares d and o and returns -1 if d < o, 0 if d == o, and +1 if d > o.
+func (d Decimal) Cmp(o Decimal) int {
+	dNeg, dMagnitude := splitSign(d.Mantissa)
+	oNeg, oMagnitude := splitSign(o.Mantissa)
+
+	switch {
+	case dNeg && !oNeg:
+		return -1
+	case !dNeg && oNeg:
+		return 1
+	}
+
+	// Widen both magnitudes to the larger scale as 128-bit values, which cannot overflow.
+	// Scales above MaxDecimalScale are clamped so that Cmp never panics on invalid decimals.
+	scale := max(d.Scale, o.Scale)
+	dHi, dLo := bits.Mul64(dMagnitude, pow10[min(int(scale-d.Scale), len(pow10)-1)])
+	oHi, oLo := bits.Mul64(oMagnitude, pow10[min(int(scale-o.Scale), len(pow10)-1)])
+
+	result := 0
+
+	switch {
+	case dHi < oHi || (dHi == oHi && dLo < oLo):
+		result = -1
+	case dHi > oHi || dLo > oLo:
+		result = 1
+	}
+
+	if dNeg {
+		return -result
+	}
+
+	return result
+}
+
+// ToInt64 returns the decimal rounded to a whole number according to mode.
+// Returns an error if the result overflows an int64.
+func (d Decimal) ToInt64(mode RoundingMode) (int64, error) {
+	rescaled, err := d.Rescale(0, mode)
+	if err != nil {
+		return 0, err
+	}
+
+	return rescaled.Mantissa, nil
+}
+
+// combine applies op to the mantissas of d and o aligned to the larger of the two scales.
+// Returns an error if that scale exceeds MaxDecimalScale or the result overflows an int64.
+func (d Decimal) combine(o Decimal, op func(Int128, Int128) (Int128, error), symbol string) (Decimal, error) {
+	scale := max(d.Scale, o.Scale)
+	if scale > MaxDecimalScale {
+		return Decimal{}, fmt.Errorf("%w (decimal scale): %d (max %d)", ErrValueOutOfRange, scale, MaxDecimalScale)
+	}
+
+	// Each aligned mantissa is below 2^63 * 10^18 < 2^123 in magnitude, so op cannot overflow.
+	result, _ := op(d.widen(scale), o.widen(scale))
+	if result.Cmp(NewInt128(int64(math.MinInt64))) < 0 || result.Cmp(NewInt128(int64(math.MaxInt64))) > 0 {
+		return Decimal{}, fmt.Errorf("decimal %w: %s %s %s", ErrValueOverflow, d, symbol, o)
+	}
+
+	return Decimal{Mantissa: int64(result.Lo), Scale: scale}, nil //nolint:gosec // the result is within the int64 range
+}
+
+// widen returns the mantissa of d at scale, which lies between the scale of d and MaxDecimalScale,
+// as an Int128.
+func (d Decimal) widen(scale uint8) Int128 {
+	widened, _ := NewInt128(d.Mantissa).Mul(NewInt128(pow10[scale-d.Scale]))
+	return widened
+}
+
+// pow10Of returns 10^n.
+// Returns an error if 10^n does not fit in an uint64.
+func pow10Of(n uint8) (uint64, error) {
+	if int(n) >= len(pow10) {
+		return 0, fmt.Errorf("%w (decimal scale): %d", ErrValueOutOfRange, n)
+	}
+
+	return pow10[n], nil
+}
+
+// mulDivSigned computes v*num/den with rounding, negating the result when negate is set.
+// Returns an error if den is zero or the result overflows an int64.
+func mulDivSigned(v int64, num, den uint64, negate bool, mode RoundingMode) (int64, error) {
+	neg, magnitude := splitSign(v)
+	neg = neg != negate
+
+	result, err := mulDivMagnitude(magnitude, num, den, neg, mode)
+	if err != nil {
+		return 0, err
+	}
+
+	return joinSign(neg, result)
+}
@@ -0,0 +1,310 @@
+package safeconversion_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	safe "github.com/bsv-blockchain/go-safe-conversion"
+)
+
+// dec builds a Decimal for test tables.
+func dec(mantissa int64, scale uint8) safe.Decimal {
+	return safe.Decimal{Mantissa: mantissa, Scale: scale}
+}
+
+// TestNewDecimal tests constructing decimals with valid and invalid scales.
+func TestNewDecimal(t *testing.T) {
+	d, err := safe.NewDecimal(12345, 3)
+	require.NoError(t, err)
+	assert.Equal(t, dec(12345, 3), d)
+
+	_, err = safe.NewDecimal(1, safe.MaxDecimalScale+1)
+	require.ErrorIs(t, err, safe.ErrValueOutOfRange)
+}
+
+// TestParseDecimal tests parsing decimal strings.
+func TestParseDecimal(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		expect  safe.Decimal
+		wantErr error
+	}{
+		{zeroValueName, "0", dec(0, 0), nil},
+		{"integer", "42", dec(42, 0), nil},
+		{"fraction", "12.345", dec(12345, 3), nil},
+		{"negative fraction", "-0.5", dec(-5, 1), nil},
+		{"explicit plus", "+1.50", dec(150, 2), nil},
+		{"leading point", ".25", dec(25, 2), nil},
+		{maxInt64Name, "9223372036854775807", dec(math.MaxInt64, 0), nil},
+		{minInt64Name, "-9223372036854775808", dec(math.MinInt64, 0), nil},
+		{"max scale", "0.000000000000000001", dec(1, 18), nil},
+		{valueTooLargeName, "9223372036854775808", safe.Decimal{}, safe.ErrValueOverflow},
+		{valueTooSmallName, "-9223372036854775809", safe.Decimal{}, safe.ErrValueOverflow},
+		{valueMuchTooLargeName, "99999999999999999999999", safe.Decimal{}, safe.ErrValueOverflow},
+		{"scale too large", "0.0000000000000000001", safe.Decimal{}, safe.ErrValueOutOfRange},
+		{"empty string", "", safe.Decimal{}, safe.ErrInvalidSyntax},
+		{"sign only", "-", safe.Decimal{}, safe.ErrInvalidSyntax},
+		{"trailing point", "1.", safe.Decimal{}, safe.ErrInvalidSyntax},
+		{"two points", "1.2.3", safe.Decimal{}, safe.ErrInvalidSyntax},
+		{"letters", "1e5", safe.Decimal{}, safe.ErrInvalidSyntax},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := safe.ParseDecimal(tt.input)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expect, result)
+		})
+	}
+}
+
+// TestDecimalString tests formatting decimals.
+func TestDecimalString(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  safe.Decimal
+		expect string
+	}{
+		{zeroValueName, dec(0, 0), "0"},
+		{"zero with scale", dec(0, 3), "0.000"},
+		{"fraction", dec(12345, 3), "12.345"},
+		{"small fraction", dec(5, 3), "0.005"},
+		{"negative fraction", dec(-5, 1), "-0.5"},
+		{minInt64Name, dec(math.MinInt64, 0), "-9223372036854775808"},
+		{"min int64 with scale", dec(math.MinInt64, 18), "-9.223372036854775808"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expect, tt.input.String())
+		})
+	}
+}
+
+// TestDecimalText tests the text marshaling round trip.
+func TestDecimalText(t *testing.T) {
+	text, err := dec(-123456, 4).MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "-12.3456", string(text))
+
+	var d safe.Decimal
+	require.NoError(t, d.UnmarshalText(text))
+	assert.Equal(t, dec(-123456, 4), d)
+
+	require.ErrorIs(t, d.UnmarshalText([]byte("abc")), safe.ErrInvalidSyntax)
+	assert.Equal(t, dec(-123456, 4), d)
+}
+
+// TestDecimalRescale tests rescaling decimals with every rounding mode.
+func TestDecimalRescale(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   safe.Decimal
+		scale   uint8
+		mode    safe.RoundingMode
+		expect  safe.Decimal
+		wantErr error
+	}{
+		{"scale up", dec(125, 2), 8, safe.RoundDown, dec(125_000_000, 8), nil},
+		{"exact scale down", dec(1200, 3), 1, safe.RoundUp, dec(12, 1), nil},
+		{"down positive", dec(125, 2), 1, safe.RoundDown, dec(12, 1), nil},
+		{"down negative", dec(-125, 2), 1, safe.RoundDown, dec(-12, 1), nil},
+		{"up positive", dec(121, 2), 1, safe.RoundUp, dec(13, 1), nil},
+		{"up negative", dec(-121, 2), 1, safe.RoundUp, dec(-13, 1), nil},
+		{"floor positive", dec(129, 2), 1, safe.RoundFloor, dec(12, 1), nil},
+		{"floor negative", dec(-121, 2), 1, safe.RoundFloor, dec(-13, 1), nil},
+		{"ceiling positive", dec(121, 2), 1, safe.RoundCeiling, dec(13, 1), nil},
+		{"ceiling negative", dec(-129, 2), 1, safe.RoundCeiling, dec(-12, 1), nil},
+		{"half up tie", dec(125, 2), 1, safe.RoundHalfUp, dec(13, 1), nil},
+		{"half up negative tie", dec(-125, 2), 1, safe.RoundHalfUp, dec(-13, 1), nil},
+		{"half up below tie", dec(124, 2), 1, safe.RoundHalfUp, dec(12, 1), nil},
+		{"half even tie to even", dec(125, 2), 1, safe.RoundHalfEven, dec(12, 1), nil},
+		{"half even tie to odd", dec(135, 2), 1, safe.RoundHalfEven, dec(14, 1), nil},
+		{"half even above tie", dec(1251, 3), 1, safe.RoundHalfEven, dec(13, 1), nil},
+		{"scale up overflows", dec(math.MaxInt64/10, 0), 8, safe.RoundDown, safe.Decimal{}, safe.ErrValueOverflow},
+		{"min int64 scale down", dec(math.MinInt64, 1), 0, safe.RoundFloor, dec(-922337203685477581, 0), nil},
+		{"target scale too large", dec(1, 0), safe.MaxDecimalScale + 1, safe.RoundDown, safe.Decimal{}, safe.ErrValueOutOfRange},
+		{"invalid source scale", dec(1, 40), 0, safe.RoundDown, safe.Decimal{}, safe.ErrValueOutOfRange},
+		{"invalid rounding mode", dec(125, 2), 1, safe.RoundingMode(99), safe.Decimal{}, safe.ErrInvalidRoundingMode},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.input.Rescale(tt.scale, tt.mode)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expect, result)
+		})
+	}
+}
+
+// TestDecimalAddSub tests checked addition and subtraction.
+func TestDecimalAddSub(t *testing.T) {
+	sum, err := dec(15, 1).Add(dec(225, 2))
+	require.NoError(t, err)
+	assert.Equal(t, dec(375, 2), sum)
+
+	diff, err := dec(15, 1).Sub(dec(225, 2))
+	require.NoError(t, err)
+	assert.Equal(t, dec(-75, 2), diff)
+
+	_, err = dec(math.MaxInt64, 0).Add(dec(1, 0))
+	require.ErrorIs(t, err, safe.ErrValueOverflow)
+
+	_, err = dec(math.MinInt64, 0).Add(dec(-1, 0))
+	require.ErrorIs(t, err, safe.ErrValueOverflow)
+
+	_, err = dec(math.MinInt64, 0).Sub(dec(1, 0))
+	require.ErrorIs(t, err, safe.ErrValueOverflow)
+
+	_, err = dec(math.MaxInt64, 0).Sub(dec(-1, 0))
+	require.ErrorIs(t, err, safe.ErrValueOverflow)
+
+	_, err = dec(math.MaxInt64, 0).Add(dec(1, 1))
+	require.ErrorIs(t, err, safe.ErrValueOverflow)
+
+	// Aligning the operands overflows an int64, but their sum does not.
+	sum, err = dec(math.MaxInt64/5, 0).Add(dec(-math.MaxInt64, 1))
+	require.NoError(t, err)
+	assert.Equal(t, dec(math.MaxInt64-4, 1), sum)
+
+	diff, err = dec(math.MaxInt64/5, 0).Sub(dec(math.MaxInt64, 1))
+	require.NoError(t, err)
+	assert.Equal(t, dec(math.MaxInt64-4, 1), diff)
+}
+
+// TestDecimalMul tests checked multiplication.
+func TestDecimalMul(t *testing.T) {
+	tests := []struct {
+		name    string
+		a, b    safe.Decimal
+		mode    safe.RoundingMode
+		expect  safe.Decimal
+		wantErr error
+	}{
+		{"fee for 250 bytes at 0.5 sat/byte", dec(250, 0), dec(5, 1), safe.RoundCeiling, dec(125, 0), nil},
+		{"rounds down", dec(3, 0), dec(15, 1), safe.RoundDown, dec(4, 0), nil},
+		{"rounds up", dec(3, 0), dec(15, 1), safe.RoundUp, dec(5, 0), nil},
+		{"negative operand", dec(3, 0), dec(-15, 1), safe.RoundFloor, dec(-5, 0), nil},
+		{"keeps receiver scale", dec(1234, 2), dec(2, 0), safe.RoundDown, dec(2468, 2), nil},
+		{"large intermediate", dec(math.MaxInt64, 0), dec(1e8, 8), safe.RoundDown, dec(math.MaxInt64, 0), nil},
+		{"overflows", dec(math.MaxInt64, 0), dec(2, 0), safe.RoundDown, safe.Decimal{}, safe.ErrValueOverflow},
+		{"min int64", dec(math.MinInt64, 0), dec(1, 0), safe.RoundDown, dec(math.MinInt64, 0), nil},
+		{"min int64 negated overflows", dec(math.MinInt64, 0), dec(-1, 0), safe.RoundDown, safe.Decimal{}, safe.ErrValueOverflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.a.Mul(tt.b, tt.mode)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expect, result)
+		})
+	}
+}
+
+// TestDecimalDiv tests checked division.
+func TestDecimalDiv(t *testing.T) {
+	tests := []struct {
+		name    string
+		a, b    safe.Decimal
+		mode    safe.RoundingMode
+		expect  safe.Decimal
+		wantErr error
+	}{
+		{"exact", dec(100, 0), dec(4, 0), safe.RoundDown, dec(25, 0), nil},
+		{"rate with scale", dec(1000, 3), dec(3, 0), safe.RoundHalfEven, dec(333, 3), nil},
+		{"divisor with scale", dec(1, 0), dec(5, 1), safe.RoundDown, dec(2, 0), nil},
+		{"rounds ceiling", dec(10, 0), dec(3, 0), safe.RoundCeiling, dec(4, 0), nil},
+		{"negative divisor", dec(10, 0), dec(-3, 0), safe.RoundFloor, dec(-4, 0), nil},
+		{"overflows", dec(math.MaxInt64, 0), dec(1, 1), safe.RoundDown, safe.Decimal{}, safe.ErrValueOverflow},
+		{"division by zero", dec(1, 0), dec(0, 3), safe.RoundDown, safe.Decimal{}, safe.ErrDivisionByZero},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.a.Div(tt.b, tt.mode)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expect, result)
+		})
+	}
+}
+
+// TestDecimalCmp tests comparing decimals at different scales.
+func TestDecimalCmp(t *testing.T) {
+	tests := []struct {
+		name   string
+		a, b   safe.Decimal
+		expect int
+	}{
+		{"equal across scales", dec(15, 1), dec(1500, 3), 0},
+		{"less", dec(15, 1), dec(1501, 3), -1},
+		{"greater", dec(2, 0), dec(1999, 3), 1},
+		{"negative less", dec(-2, 0), dec(-1999, 3), -1},
+		{"mixed signs", dec(-1, 18), dec(0, 0), -1},
+		{"zero against negative", dec(0, 0), dec(-1, 0), 1},
+		{"beyond int64 when widened", dec(math.MaxInt64, 0), dec(math.MaxInt64, 18), 1},
+		{"min int64", dec(math.MinInt64, 0), dec(math.MinInt64, 1), -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expect, tt.a.Cmp(tt.b))
+			assert.Equal(t, -tt.expect, tt.b.Cmp(tt.a))
+		})
+	}
+}
+
+// TestDecimalToInt64 tests rounding decimals to whole numbers.
+func TestDecimalToInt64(t *testing.T) {
+	v, err := dec(12345, 3).ToInt64(safe.RoundHalfUp)
+	require.NoError(t, err)
+	assert.Equal(t, int64(12), v)
+
+	v, err = dec(-12500, 3).ToInt64(safe.RoundHalfEven)
+	require.NoError(t, err)
+	assert.Equal(t, int64(-12), v)
+
+	_, err = dec(1, 30).ToInt64(safe.RoundDown)
+	require.ErrorIs(t, err, safe.ErrValueOutOfRange)
+}
+
+// ExampleDecimal_Mul demonstrates computing a fee from a fractional fee rate.
+func ExampleDecimal_Mul() {
+	rate, err := safe.ParseDecimal("0.5") // sat/byte
+	if err != nil {
+		fmt.Println("error:", err)
+		return
+	}
+
+	fee, err := safe.Decimal{Mantissa: 225}.Mul(rate, safe.RoundCeiling)
+	if err != nil {
+		fmt.Println("error:", err)
+		return
+	}
+	fmt.Println(fee)
+	// Output: 113
+}
@@ -0,0 +1,1805 @@
+package safeconversion_test
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/binary"
+	"errors"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"math"
+	"math/big"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+	"unicode"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	safe "github.com/bsv-blockchain/go-safe-conversion"
+	"github.com/bsv-blockchain/go-safe-conversion/safeconversiontest"
+)
+
+// errorClass is the kind of failure a conversion reports, independent of the message.
+type errorClass int
+
+const (
+	classNone errorClass = iota
+	classNegative
+	classBelowMin
+	classAboveMax
+	classOutOfRange
+	classInvalidRune
+	classNonReal
+	classFractional
+	classInexact
+	classUnderflow
+	classInvalidSyntax
+	classDivisionByZero
+	classUnknown
+)
+
+// String returns the name of the class.
+func (c errorClass) String() string {
+	switch c {
+	case classNone:
+		return "none"
+	case classNegative:
+		return "negative"
+	case classBelowMin:
+		return "below minimum"
+	case classAboveMax:
+		return "above maximum"
+	case classOutOfRange:
+		return "out of range"
+	case classInvalidRune:
+		return "invalid rune"
+	case classNonReal:
+		return "non-real"
+	case classFractional:
+		return "fractional"
+	case classInexact:
+		return "inexact"
+	case classUnderflow:
+		return "underflow"
+	case classInvalidSyntax:
+		return "invalid syntax"
+	case classDivisionByZero:
+		return "division by zero"
+	default:
+		return "unknown"
+	}
+}
+
+// classify returns the class of err according to the package sentinel it matches.
+func classify(err error) errorClass {
+	switch {
+	case err == nil:
+		return classNone
+	case errors.Is(err, safe.ErrInvalidRune):
+		return classInvalidRune
+	case errors.Is(err, safe.ErrNonRealValue):
+		return classNonReal
+	case errors.Is(err, safe.ErrFractionalValue):
+		return classFractional
+	case errors.Is(err, safe.ErrInexactValue):
+		return classInexact
+	case errors.Is(err, safe.ErrValueUnderflow):
+		return classUnderflow
+	case errors.Is(err, safe.ErrInvalidSyntax):
+		return classInvalidSyntax
+	case errors.Is(err, safe.ErrDivisionByZero):
+		return classDivisionByZero
+	case errors.Is(err, safe.ErrNegativeValueCannotBeConverted):
+		return classNegative
+	case errors.Is(err, safe.ErrValueExceedsLimit):
+		return classAboveMax
+	case errors.Is(err, safe.ErrValueOutOfRange), errors.Is(err, safe.ErrValueOverflow):
+		// The wide types and checked arithmetic report ErrValueOverflow on both sides of the range.
+		return classOutOfRange
+	default:
+		return classUnknown
+	}
+}
+
+// agrees reports whether an implementation reporting got agrees with the oracle expecting want.
+// ErrValueOutOfRange and ErrValueOverflow do not tell on which side of the range the value is,
+// so they agree with every range failure.
+func agrees(want, got errorClass) bool {
+	switch want {
+	case classNegative, classBelowMin, classAboveMax:
+		return got == want || got == classOutOfRange
+	default:
+		return got == want
+	}
+}
+
+// differential checks one exported function against the reference implementation. The
+// function is called with inputs derived from a, b and c, so that a single fuzz target
+// can drive every function.
+type differential struct {
+	name  string
+	check func(t *testing.T, a, b, c uint64)
+}
+
+// differentials returns the differential cases of every exported function and method that can fail.
+func differentials() []differential {
+	return append([]differential{
+		integerDifferential("IntToUint32", safe.IntToUint32),
+		integerDifferential("Uint64ToUint32", safe.Uint64ToUint32),
+		integerDifferential("Int64ToUint64", safe.Int64ToUint64),
+		integerDifferential("IntToUint64", safe.IntToUint64),
+		integerDifferential("Uint64ToInt", safe.Uint64ToInt),
+		integerDifferential("Int64ToInt32", safe.Int64ToInt32),
+		integerDifferential("IntToInt32", safe.IntToInt32),
+		integerDifferential("Int32ToUint32", safe.Int32ToUint32),
+		integerDifferential("Int64ToUint32", safe.Int64ToUint32),
+		integerDifferential("BigWordToUint32", safe.BigWordToUint32),
+		integerDifferential("IntToUint16", safe.IntToUint16),
+		integerDifferential("IntToInt16", safe.IntToInt16),
+		integerDifferential("UintToUint32", safe.UintToUint32),
+		timeDifferential(),
+		integerDifferential("Uint32ToUint8", safe.Uint32ToUint8),
+		integerDifferential("UintptrToInt", safe.UintptrToInt),
+		integerDifferential("IntToUintptr", safe.IntToUintptr),
+		integerDifferential("Int64ToUintptr", safe.Int64ToUintptr),
+		integerDifferential("Uint64ToUintptr", safe.Uint64ToUintptr),
+		offsetDifferential(),
+		integerDifferential("Uint64ToInt64", safe.Uint64ToInt64),
+		integerDifferential("Uint32ToInt32", safe.Uint32ToInt32),
+		integerDifferential("Uint64ToInt32", safe.Uint64ToInt32),
+		integerDifferential("Uint32ToInt64", safe.Uint32ToInt64),
+		integerDifferential("Uint32ToUint64", safe.Uint32ToUint64),
+		integerDifferential("Uint64ToUint16", safe.Uint64ToUint16),
+		integerDifferential("IntToByte", safe.IntToByte),
+		runeDifferential("IntToRune", safe.IntToRune),
+		runeDifferential("Uint32ToRune", safe.Uint32ToRune),
+		opcodeDifferential(),
+		complexDifferential("Complex128ToFloat64", safe.Complex128ToFloat64),
+		complexDifferential("Complex64ToFloat32", safe.Complex64ToFloat32),
+		complexIntegerDifferential("ComplexTo[int64]", safe.ComplexTo[int64, complex128]),
+		complexIntegerDifferential("ComplexTo[uint8]", safe.ComplexTo[uint8, complex64]),
+		narrowComplexDifferential(),
+		floatDifferential("Float64ToFloat32", safe.Float64ToFloat32, safe.RoundHalfEven, false),
+		floatDifferential("Float64ToFloat32Exact", safe.Float64ToFloat32Exact, safe.RoundHalfEven, true),
+		bytesDifferential(),
+		signedBytesDifferential(),
+		minimalBytesDifferential(),
+		mulDivDifferential(),
+		newWideDifferential("NewUint128[int64]", safe.NewUint128[int64], safe.Uint128.Big, 128, false),
+		newWideDifferential("NewUint128[uint64]", safe.NewUint128[uint64], safe.Uint128.Big, 128, false),
+		fromBigDifferential("Uint128FromBig", safe.Uint128FromBig, safe.Uint128.Big, 128, false),
+		uint128ToDifferential[uint64]("Uint128To[uint64]"),
+		uint128ToDifferential[int64]("Uint128To[int64]"),
+		uint128ToDifferential[int8]("Uint128To[int8]"),
+		newInt128Differential[int64]("NewInt128[int64]"),
+		newInt128Differential[uint64]("NewInt128[uint64]"),
+		fromBigDifferential("Int128FromBig", safe.Int128FromBig, safe.Int128.Big, 128, true),
+		int128ToDifferential[int64]("Int128To[int64]"),
+		int128ToDifferential[uint64]("Int128To[uint64]"),
+		int128ToDifferential[uint16]("Int128To[uint16]"),
+		newWideDifferential("NewUint256[int64]", safe.NewUint256[int64], safe.Uint256.Big, 256, false),
+		newWideDifferential("NewUint256[uint64]", safe.NewUint256[uint64], safe.Uint256.Big, 256, false),
+		fromBigDifferential("Uint256FromBig", safe.Uint256FromBig, safe.Uint256.Big, 256, false),
+		uint256BytesDifferential("Uint256FromBytesBE", safe.Uint256FromBytesBE, binary.BigEndian),
+		uint256BytesDifferential("Uint256FromBytesLE", safe.Uint256FromBytesLE, binary.LittleEndian),
+		compactDifferential(),
+		uint256Uint64Differential(),
+		divRoundDifferential[int64]("DivRound[int64]"),
+		divRoundDifferential[uint8]("DivRound[uint8]"),
+		divRoundDifferential[int8]("DivRound[int8]"),
+		mulDivRoundDifferential[int64]("MulDivRound[int64]"),
+		mulDivRoundDifferential[uint64]("MulDivRound[uint64]"),
+		mulDivRoundDifferential[int16]("MulDivRound[int16]"),
+		scaleCheckedDifferential[int64]("ScaleChecked[int64]"),
+		scaleCheckedDifferential[uint32]("ScaleChecked[uint32]"),
+		decimalRescaleDifferential(),
+		decimalMulDifferential(),
+		decimalDivDifferential(),
+		decimalAlignedDifferential("Decimal.Add", safe.Decimal.Add, (*big.Int).Add),
+		decimalAlignedDifferential("Decimal.Sub", safe.Decimal.Sub, (*big.Int).Sub),
+		decimalToInt64Differential(),
+		newDecimalDifferential(),
+		parseDecimalDifferential(),
+		readDifferential("ReadUint16LE", safe.ReadUint16LE),
+		readDifferential("ReadUint32LE", safe.ReadUint32LE),
+		readDifferential("ReadUint64LE", safe.ReadUint64LE),
+		readLenPrefixedDifferential(),
+		putDifferential[uint16]("PutUint16Checked", safe.PutUint16Checked),
+		putDifferential[uint32]("PutUint32Checked", safe.PutUint32Checked),
+		lenDifferential("LenToUint16", safe.LenToUint16[[]struct{}]),
+		lenDifferential("LenToUint32", safe.LenToUint32[[]struct{}]),
+		makeSliceDifferential[byte]("MakeSliceChecked[byte]", 1),
+		makeSliceDifferential[uint64]("MakeSliceChecked[uint64]", 8),
+		makeSliceDifferential[struct{}]("MakeSliceChecked[struct{}]", 0),
+		boundedSetDifferential[int8]("Bounded.Set[int8]"),
+		boundedSetDifferential[int64]("Bounded.Set[int64]"),
+		boundedSetDifferential[uint16]("Bounded.Set[uint16]"),
+		boundedSetDifferential[uint64]("Bounded.Set[uint64]"),
+		rangeCheckDifferential[int16]("Range.Check[int16]"),
+		rangeCheckDifferential[uint32]("Range.Check[uint32]"),
+		counterStoreDifferential[int32]("CheckedCounter.Store[int32]"),
+		counterStoreDifferential[uint64]("CheckedCounter.Store[uint64]"),
+		counterUpdateDifferential("CheckedCounter.Add[int32]", (*safe.CheckedCounter[int32]).Add, (*big.Int).Add),
+		counterUpdateDifferential("CheckedCounter.Add[uint64]", (*safe.CheckedCounter[uint64]).Add, (*big.Int).Add),
+		counterUpdateDifferential("CheckedCounter.Sub[int64]", (*safe.CheckedCounter[int64]).Sub, (*big.Int).Sub),
+		counterUpdateDifferential("CheckedCounter.Sub[uint8]", (*safe.CheckedCounter[uint8]).Sub, (*big.Int).Sub),
+	}, slices.Concat(roundDifferentials(), wideArithmeticDifferentials(), sqlDifferentials(), generatedDifferentials())...)
+}
+
+// roundDifferentials returns the differential cases of Float64ToFloat32Round, one per rounding mode.
+func roundDifferentials() []differential {
+	modes := roundingModes()
+
+	cases := make([]differential, 0, len(modes))
+	for _, mode := range modes {
+		fn := func(value float64) (float32, error) { return safe.Float64ToFloat32Round(value, mode) }
+		cases = append(cases, floatDifferential("Float64ToFloat32Round["+mode.String()+"]", fn, mode, false))
+	}
+
+	return cases
+}
+
+// referenceRange is the reference implementation of a range check: it returns value when
+// it lies within [minValue, maxValue], and the class of the failure otherwise.
+func referenceRange(value, minValue, maxValue *big.Int) (*big.Int, errorClass) {
+	switch {
+	case value.Cmp(minValue) >= 0 && value.Cmp(maxValue) <= 0:
+		return value, classNone
+	case value.Sign() < 0 && minValue.Sign() == 0:
+		return nil, classNegative
+	case value.Cmp(minValue) < 0:
+		return nil, classBelowMin
+	default:
+		return nil, classAboveMax
+	}
+}
+
+// referenceInteger is the reference implementation of converting value to D.
+func referenceInteger[D safe.Integer](value *big.Int) (*big.Int, errorClass) {
+	minValue, maxValue := safeconversiontest.Bounds[D]()
+	return referenceRange(value, minValue, maxValue)
+}
+
+// requireClass requires that the error of a function agrees with the oracle.
+func requireClass(t *testing.T, name string, input any, err error, class errorClass) {
+	t.Helper()
+
+	gotClass := classify(err)
+	require.Truef(t, agrees(class, gotClass), "%s(%v): got error class %s (%v), oracle expects %s", name, input, gotClass, err, class)
+}
+
+// assertClass asserts that the error of a conversion agrees with the oracle, and that a failed
+// conversion returns a *ConversionError. It reports whether the conversion succeeded.
+func assertClass(t *testing.T, name string, input any, err error, class errorClass) bool {
+	t.Helper()
+
+	requireClass(t, name, input, err, class)
+
+	if class != classNone {
+		var conversionErr *safe.ConversionError
+		assert.ErrorAsf(t, err, &conversionErr, "%s(%v)", name, input)
+	}
+
+	return class == classNone
+}
+
+// assertAgrees asserts that the result and error of an integer conversion agree with the oracle.
+// A failed conversion must return zero.
+func assertAgrees(t *testing.T, name string, input any, got *big.Int, err error, want *big.Int, class errorClass) {
+	t.Helper()
+
+	if !assertClass(t, name, input, err, class) {
+		assert.Zerof(t, got.Sign(), "%s(%v): failed conversion returned %s", name, input, got)
+		return
+	}
+
+	assert.Equalf(t, want.String(), got.String(), "%s(%v)", name, input)
+}
+
+// assertAgreesArithmetic asserts that the result and error of checked arithmetic agree with the
+// oracle. Unlike a conversion, failed arithmetic returns a plain error, but it must return zero.
+func assertAgreesArithmetic(t *testing.T, name string, input any, got *big.Int, err error, want *big.Int, class errorClass) {
+	t.Helper()
+
+	requireClass(t, name, input, err, class)
+
+	if class != classNone {
+		assert.Zerof(t, got.Sign(), "%s(%v): failed operation returned %s", name, input, got)
+		return
+	}
+
+	assert.Equalf(t, want.String(), got.String(), "%s(%v)", name, input)
+}
+
+// assertAgreesBits asserts that the result and error of a floating-point or complex conversion
+// agree with the oracle, comparing the bit patterns of the parts so that NaN and negative zero
+// are checked exactly. A failed conversion must return zero.
+func assertAgreesBits(t *testing.T, name string, input any, got [2]uint64, err error, want [2]uint64, class errorClass) {
+	t.Helper()
+
+	if !assertClass(t, name, input, err, class) {
+		assert.Zerof(t, got, "%s(%v): failed conversion returned %#x", name, input, got)
+		return
+	}
+
+	assert.Equalf(t, want, got, "%s(%v)", name, input)
+}
+
+// integerDifferential checks an integer conversion, called with a truncated to S.
+func integerDifferential[S, D safe.Integer](name string, fn func(S) (D, error)) differential {
+	return differential{name: name, check: func(t *testing.T, a, _, _ uint64) {
+		t.Helper()
+
+		value := S(a)
+		got, err := fn(value)
+		want, class := referenceInteger[D](safeconversiontest.ToBig(value))
+		assertAgrees(t, name, value, safeconversiontest.ToBig(got), err, want, class)
+	}}
+}
+
+// runeDifferential checks a rune conversion, called with a truncated to S. The reference
+// accepts the Unicode code points outside the surrogate range.
+func runeDifferential[S safe.Integer](name string, fn func(S) (rune, error)) differential {
+	return differential{name: name, check: func(t *testing.T, a, _, _ uint64) {
+		t.Helper()
+
+		value := S(a)
+		got, err := fn(value)
+
+		want, class := referenceRange(safeconversiontest.ToBig(value), big.NewInt(0), big.NewInt(unicode.MaxRune))
+		if class != classNone || (want.Cmp(big.NewInt(0xD800)) >= 0 && want.Cmp(big.NewInt(0xDFFF)) <= 0) {
+			class = classInvalidRune
+		}
+
+		assertAgrees(t, name, value, safeconversiontest.ToBig(got), err, want, class)
+	}}
+}
+
+// opcodeDifferential checks OpcodeFromInt, called with a truncated to int. The reference
+// maps -1 to OP_1NEGATE (0x4f), 0 to OP_0 and n in 1..16 to OP_n (0x50 + n).
+func opcodeDifferential() differential {
+	return differential{name: "OpcodeFromInt", check: func(t *testing.T, a, _, _ uint64) {
+		t.Helper()
+
+		value := int(a) //nolint:gosec // the fuzz input is truncated on purpose
+		got, err := safe.OpcodeFromInt(value)
+
+		n := safeconversiontest.ToBig(value)
+		want, class := referenceRange(n, big.NewInt(-1), big.NewInt(16))
+		switch {
+		case class != classNone:
+			class = classOutOfRange
+		case n.Sign() < 0:
+			want = big.NewInt(0x4f)
+		case n.Sign() > 0:
+			want = new(big.Int).Add(n, big.NewInt(0x50))
+		}
+
+		assertAgrees(t, "OpcodeFromInt", value, safeconversiontest.ToBig(got), err, want, class)
+	}}
+}
+
+// timeDifferential checks TimeToUint32, called with a as a Unix timestamp.
+func timeDifferential() differential {
+	return differential{name: "TimeToUint32", check: func(t *testing.T, a, _, _ uint64) {
+		t.Helper()
+
+		seconds := int64(a) //nolint:gosec // the fuzz input is reinterpreted as a signed timestamp
+		got, err := safe.TimeToUint32(time.Unix(seconds, 0))
+		want, class := referenceInteger[uint32](big.NewInt(seconds))
+		assertAgrees(t, "TimeToUint32", seconds, safeconversiontest.ToBig(got), err, want, class)
+	}}
+}
+
+// offsetDifferential checks CheckedOffset, called with base a truncated to uintptr, index b
+// and element size c. The reference computes base + index*elemSize without overflow.
+func offsetDifferential() differential {
+	return differential{name: "CheckedOffset", check: func(t *testing.T, a, b, c uint64) {
+		t.Helper()
+
+		base := uintptr(a)
+		got, err := safe.CheckedOffset(base, b, c)
+
+		address := new(big.Int).Mul(new(big.Int).SetUint64(b), new(big.Int).SetUint64(c))
+		address.Add(address, safeconversiontest.ToBig(base))
+		want, class := referenceInteger[uintptr](address)
+		assertAgrees(t, "CheckedOffset", [3]uint64{uint64(base), b, c}, safeconversiontest.ToBig(got), err, want, class)
+	}}
+}
+
+// floatBits returns the bit pattern of a float32 or float64 value.
+func floatBits[F float32 | float64](value F) uint64 {
+	if f, ok := any(value).(float32); ok {
+		return uint64(math.Float32bits(f))
+	}
+
+	return math.Float64bits(float64(value))
+}
+
+// referenceReal is the reference implementation of taking the real part of the complex number
+// re + im*i: it fails if im is non-zero or NaN, or if re is NaN or infinite.
+func referenceReal(re, im float64) errorClass {
+	switch {
+	case im != 0 || math.IsNaN(im):
+		return classNonReal
+	case math.IsNaN(re) || math.IsInf(re, 0):
+		return classOutOfRange
+	default:
+		return classNone
+	}
+}
+
+// complexOf returns the complex number with the real part of bits a and the imaginary part of bits b.
+func complexOf[C safe.Complex](a, b uint64) C {
+	var zero C
+	if _, ok := any(zero).(complex64); ok {
+		return C(complex(math.Float32frombits(uint32(a)), math.Float32frombits(uint32(b)))) //nolint:gosec // the low bits are the float32 pattern
+	}
+
+	return C(complex(math.Float64frombits(a), math.Float64frombits(b)))
+}
+
+// complexDifferential checks a conversion from a complex type to the float type of its parts,
+// called with the real part of bits a and the imaginary part of bits b.
+func complexDifferential[C safe.Complex, F float32 | float64](name string, fn func(C) (F, error)) differential {
+	return differential{name: name, check: func(t *testing.T, a, b, _ uint64) {
+		t.Helper()
+
+		value := complexOf[C](a, b)
+		got, err := fn(value)
+
+		re := real(complex128(value))
+		class := referenceReal(re, imag(complex128(value)))
+		assertAgreesBits(t, name, value, [2]uint64{floatBits(got)}, err, [2]uint64{floatBits(F(re))}, class)
+	}}
+}
+
+// complexIntegerDifferential checks a conversion from a complex type to an integer type,
+// called with the real part of bits a and the imaginary part of bits b.
+func complexIntegerDifferential[C safe.Complex, D safe.Integer](name string, fn func(C) (D, error)) differential {
+	return differential{name: name, check: func(t *testing.T, a, b, _ uint64) {
+		t.Helper()
+
+		value := complexOf[C](a, b)
+		got, err := fn(value)
+
+		re := real(complex128(value))
+		class := referenceReal(re, imag(complex128(value)))
+
+		var want *big.Int
+		if class == classNone {
+			want, class = referenceFloatInteger[D](re)
+		}
+
+		assertAgrees(t, name, value, safeconversiontest.ToBig(got), err, want, class)
+	}}
+}
+
+// referenceFloatInteger is the reference implementation of converting a float64 to D: it fails
+// if the value is NaN or infinite, has a fractional part, or is outside the range of D.
+func referenceFloatInteger[D safe.Integer](value float64) (*big.Int, errorClass) {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return nil, classOutOfRange
+	}
+
+	f := new(big.Float).SetFloat64(value)
+	if !f.IsInt() {
+		return nil, classFractional
+	}
+
+	n, _ := f.Int(nil)
+
+	return referenceInteger[D](n)
+}
+
+// referenceFloat32 is the reference implementation of rounding a float64 to float32 according
+// to mode. It rounds the magnitude to an integer multiple of the float32 quantum at its exponent
+// with math/big, fails on results beyond the float32 range, on inexact values below the smallest
+// normal float32, and on any inexact value when exact is set. NaN and infinities are preserved.
+func referenceFloat32(value float64, mode safe.RoundingMode, exact bool) (uint64, errorClass) {
+	if math.IsNaN(value) || math.IsInf(value, 0) || value == 0 {
+		return floatBits(float32(value)), classNone
+	}
+
+	x := new(big.Float).SetFloat64(value)
+	neg := x.Signbit()
+	x.Abs(x)
+
+	// Beyond the range of a float32 no float32 lies above the value and the nearest one is
+	// infinite, so only rounding toward zero gives a finite result: math.MaxFloat32.
+	limit := new(big.Float).SetMantExp(big.NewFloat(1), 128)
+	if x.Cmp(limit) >= 0 {
+		if mode != safe.RoundDown && (mode != safe.RoundFloor || neg) && (mode != safe.RoundCeiling || !neg) {
+			return 0, classAboveMax
+		}
+
+		return floatBits(float32(math.Copysign(math.MaxFloat32, value))), classNone
+	}
+
+	// x = m * 2^e with 0.5 <= m < 1, so a float32 at this exponent has a quantum of 2^(e-24),
+	// and subnormal float32 values have a quantum of 2^-149.
+	quantum := max(x.MantExp(nil)-24, -149)
+
+	scaled := new(big.Float).SetMantExp(x, -quantum)
+	n, _ := scaled.Int(nil)
+	frac := new(big.Float).SetPrec(256).Sub(scaled, new(big.Float).SetInt(n))
+	half := frac.Cmp(big.NewFloat(0.5))
+	inexact := frac.Sign() != 0
+
+	var up bool
+	switch mode {
+	case safe.RoundDown:
+	case safe.RoundUp:
+		up = inexact
+	case safe.RoundFloor:
+		up = inexact && neg
+	case safe.RoundCeiling:
+		up = inexact && !neg
+	case safe.RoundHalfUp:
+		up = half >= 0
+	case safe.RoundHalfEven:
+		up = half > 0 || (half == 0 && n.Bit(0) == 1)
+	}
+
+	if up {
+		n.Add(n, big.NewInt(1))
+	}
+
+	result := new(big.Float).SetMantExp(new(big.Float).SetInt(n), quantum)
+	switch {
+	case result.Cmp(limit) >= 0:
+		return 0, classAboveMax
+	case inexact && math.Abs(value) < 0x1p-126:
+		return 0, classUnderflow
+	case inexact && exact:
+		return 0, classInexact
+	}
+
+	if neg {
+		result.Neg(result)
+	}
+
+	narrowed, _ := result.Float32()
+
+	return floatBits(narrowed), classNone
+}
+
+// floatDifferential checks a conversion from float64 to float32, called with the float64 of bits a.
+func floatDifferential(name string, fn func(float64) (float32, error), mode safe.RoundingMode, exact bool) differential {
+	return differential{name: name, check: func(t *testing.T, a, _, _ uint64) {
+		t.Helper()
+
+		value := math.Float64frombits(a)
+		got, err := fn(value)
+		want, class := referenceFloat32(value, mode, exact)
+		assertAgreesBits(t, name, value, [2]uint64{floatBits(got)}, err, [2]uint64{want}, class)
+	}}
+}
+
+// narrowComplexDifferential checks Complex128ToComplex64, called with the real part of bits a
+// and the imaginary part of bits b. The reference narrows each part exactly and fails on the
+// first part that does not narrow.
+func narrowComplexDifferential() differential {
+	return differential{name: "Complex128ToComplex64", check: func(t *testing.T, a, b, _ uint64) {
+		t.Helper()
+
+		value := complexOf[complex128](a, b)
+		got, err := safe.Complex128ToComplex64(value)
+
+		var want [2]uint64
+		class := classNone
+
+		for i, part := range []float64{real(value), imag(value)} {
+			if class == classNone {
+				want[i], class = referenceFloat32(part, safe.RoundHalfEven, true)
+			}
+		}
+
+		assertAgreesBits(t, "Complex128ToComplex64", value, [2]uint64{floatBits(real(got)), floatBits(imag(got))}, err, want, class)
+	}}
+}
+
+// encodingOf returns the byte encoding checked by the byte differentials: the first c%10 bytes of
+// a and b in big-endian order, stored in the byte order selected by bit 8 of c.
+func encodingOf(a, b, c uint64) ([]byte, binary.ByteOrder) {
+	buf := binary.BigEndian.AppendUint64(binary.BigEndian.AppendUint64(nil, a), b)[:c%10]
+	if c&0x100 != 0 {
+		return buf, binary.LittleEndian
+	}
+
+	return buf, binary.BigEndian
+}
+
+// bigEndianOf returns a big-endian copy of an encoding stored in the given order.
+func bigEndianOf(b []byte, order binary.ByteOrder) []byte {
+	be := slices.Clone(b)
+	if order == binary.LittleEndian {
+		slices.Reverse(be)
+	}
+
+	return be
+}
+
+// referenceLength is the reference implementation of a variable-length encoding length check.
+func referenceLength(b []byte) errorClass {
+	switch {
+	case len(b) == 0:
+		return classInvalidSyntax
+	case len(b) > 8:
+		return classOutOfRange
+	default:
+		return classNone
+	}
+}
+
+// minimalLength returns the fewest bytes that hold magnitude with signBits bits reserved for the sign.
+func minimalLength(magnitude *big.Int, signBits int) int {
+	return max(1, (magnitude.BitLen()+signBits+7)/8)
+}
+
+// bytesDifferential checks BytesToUint64. The reference decodes with math/big and requires the
+// encoding to be as long as the minimal encoding of the value.
+func bytesDifferential() differential {
+	return differential{name: "BytesToUint64", check: func(t *testing.T, a, b, c uint64) {
+		t.Helper()
+
+		input, order := encodingOf(a, b, c)
+		got, err := safe.BytesToUint64(input, order)
+
+		want := new(big.Int).SetBytes(bigEndianOf(input, order))
+		class := referenceLength(input)
+		if class == classNone && len(input) > minimalLength(want, 0) {
+			class = classInvalidSyntax
+		}
+
+		assertAgrees(t, "BytesToUint64", input, safeconversiontest.ToBig(got), err, want, class)
+	}}
+}
+
+// signedBytesDifferential checks BytesToInt64Signed with the encoding selected by bit 9 of c. The
+// reference decodes with math/big and requires the encoding to be as long as the minimal encoding
+// of the value, and sign-magnitude zero to be positive.
+func signedBytesDifferential() differential {
+	return differential{name: "BytesToInt64Signed", check: func(t *testing.T, a, b, c uint64) {
+		t.Helper()
+
+		input, order := encodingOf(a, b, c)
+		encoding := safe.TwosComplement
+		if c&0x200 != 0 {
+			encoding = safe.SignMagnitude
+		}
+
+		got, err := safe.BytesToInt64Signed(input, order, encoding)
+
+		class := referenceLength(input)
+		want := new(big.Int)
+
+		if class == classNone {
+			be := bigEndianOf(input, order)
+			negative := be[0]&0x80 != 0
+			want.SetBytes(be)
+
+			var magnitude *big.Int
+			if encoding == safe.TwosComplement {
+				if negative {
+					want.Sub(want, new(big.Int).Lsh(big.NewInt(1), uint(8*len(be))))
+				}
+
+				// The magnitude of a negative value -m is m-1 in the bits below the sign.
+				magnitude = new(big.Int).Abs(want)
+				if negative {
+					magnitude.Sub(magnitude, big.NewInt(1))
+				}
+			} else {
+				want.SetBit(want, 8*len(be)-1, 0)
+				magnitude = new(big.Int).Set(want)
+				if negative {
+					want.Neg(want)
+				}
+			}
+
+			if len(be) > minimalLength(magnitude, 1) || (negative && want.Sign() == 0) {
+				class = classInvalidSyntax
+			}
+		}
+
+		assertAgrees(t, "BytesToInt64Signed", [2]any{input, encoding}, safeconversiontest.ToBig(got), err, want, class)
+	}}
+}
+
+// minimalBytesDifferential checks Uint64ToMinimalBytes, called with a and the byte order selected
+// by bit 8 of c. The reference encodes with math/big.
+func minimalBytesDifferential() differential {
+	return differential{name: "Uint64ToMinimalBytes", check: func(t *testing.T, a, _, c uint64) {
+		t.Helper()
+
+		_, order := encodingOf(a, 0, c)
+		value := new(big.Int).SetUint64(a)
+		want := value.FillBytes(make([]byte, minimalLength(value, 0)))
+		if order == binary.LittleEndian {
+			slices.Reverse(want)
+		}
+
+		assert.Equal(t, want, safe.Uint64ToMinimalBytes(a, order), "Uint64ToMinimalBytes(%d, %v)", a, order)
+	}}
+}
+
+// readDifferential checks ReadUint16LE, ReadUint32LE or ReadUint64LE, reading the little-endian
+// bytes of a with the limit of b truncated to T. The reference decodes with math/big.
+func readDifferential[T uint16 | uint32 | uint64](name string, read func(io.Reader, T) (T, error)) differential {
+	return differential{name: name, check: func(t *testing.T, a, b, _ uint64) {
+		t.Helper()
+
+		_, maxValue := safeconversiontest.Bounds[T]()
+		input := binary.LittleEndian.AppendUint64(nil, a)[:maxValue.BitLen()/8]
+
+		limit := T(b)
+		got, err := read(bytes.NewReader(input), limit)
+
+		value := new(big.Int).SetBytes(bigEndianOf(input, binary.LittleEndian))
+		want, class := referenceRange(value, new(big.Int), safeconversiontest.ToBig(limit))
+		assertAgreesArithmetic(t, name, [2]any{input, limit}, safeconversiontest.ToBig(got), err, want, class)
+	}}
+}
+
+// readLenPrefixedDifferential checks ReadLenPrefixed, reading the length prefix a followed by
+// that many bytes of c, with the limit of b truncated to an int16 so that no read exceeds 32 KiB.
+func readLenPrefixedDifferential() differential {
+	return differential{name: "ReadLenPrefixed", check: func(t *testing.T, a, b, c uint64) {
+		t.Helper()
+
+		maxLen := int(int16(b)) //nolint:gosec // the fuzz input is truncated on purpose
+
+		want, class := referenceRange(new(big.Int).SetUint64(a), new(big.Int), big.NewInt(int64(maxLen)))
+
+		var payload []byte
+		if class == classNone {
+			payload = make([]byte, a)
+			for i := range payload {
+				payload[i] = byte(c >> (8 * (i % 8)))
+			}
+		}
+
+		input := append(binary.LittleEndian.AppendUint64(nil, a), payload...)
+		got, err := safe.ReadLenPrefixed(bytes.NewReader(input), maxLen)
+
+		requireClass(t, "ReadLenPrefixed", [2]any{a, maxLen}, err, class)
+		if class == classNone {
+			assert.Equalf(t, want.Uint64(), uint64(len(got)), "ReadLenPrefixed(%d, %d)", a, maxLen)
+			assert.Equalf(t, payload, got, "ReadLenPrefixed(%d, %d)", a, maxLen)
+		} else {
+			assert.Nilf(t, got, "ReadLenPrefixed(%d, %d): failed read returned data", a, maxLen)
+		}
+	}}
+}
+
+// putDifferential checks PutUint16Checked or PutUint32Checked, called with a truncated to int and
+// a buffer of 8 bytes. The reference encodes the value in little-endian order with math/big.
+func putDifferential[D uint16 | uint32](name string, put func([]byte, int) error) differential {
+	return differential{name: name, check: func(t *testing.T, a, _, _ uint64) {
+		t.Helper()
+
+		value := int(a) //nolint:gosec // the fuzz input is truncated on purpose
+		buf := make([]byte, 8)
+		err := put(buf, value)
+
+		want, class := referenceInteger[D](safeconversiontest.ToBig(value))
+		if !assertClass(t, name, value, err, class) {
+			assert.Equalf(t, make([]byte, 8), buf, "%s(%d): failed encoding wrote to the buffer", name, value)
+			return
+		}
+
+		_, maxValue := safeconversiontest.Bounds[D]()
+		encoded := want.FillBytes(make([]byte, maxValue.BitLen()/8))
+		slices.Reverse(encoded)
+		assert.Equalf(t, encoded, buf[:len(encoded)], "%s(%d)", name, value)
+	}}
+}
+
+// lenDifferential checks LenToUint16 or LenToUint32, called with a slice of empty structs whose
+// length is a masked to the range of an int, so that no memory is allocated.
+func lenDifferential[D safe.Integer](name string, fn func([]struct{}) (D, error)) differential {
+	return differential{name: name, check: func(t *testing.T, a, _, _ uint64) {
+		t.Helper()
+
+		n := int(a & math.MaxInt) //nolint:gosec // the length is masked to the range of an int
+		got, err := fn(make([]struct{}, n))
+		want, class := referenceInteger[D](safeconversiontest.ToBig(n))
+		assertAgrees(t, name, n, safeconversiontest.ToBig(got), err, want, class)
+	}}
+}
+
+// makeSliceDifferential checks MakeSliceChecked for elements of the given size, called with
+// length a and the limit of b truncated to an int16, so that no allocation exceeds 32 KiB.
+func makeSliceDifferential[T any](name string, size int64) differential {
+	return differential{name: name, check: func(t *testing.T, a, b, _ uint64) {
+		t.Helper()
+
+		maxBytes := int(int16(b)) //nolint:gosec // the fuzz input is truncated on purpose
+		got, err := safe.MakeSliceChecked[T](a, maxBytes)
+
+		n := new(big.Int).SetUint64(a)
+		want, class := referenceInteger[int](n)
+		if class == classNone {
+			_, class = referenceRange(new(big.Int).Mul(n, big.NewInt(size)), new(big.Int), big.NewInt(int64(maxBytes)))
+		}
+
+		assertAgreesArithmetic(t, name, [2]any{a, maxBytes}, big.NewInt(int64(len(got))), err, want, class)
+	}}
+}
+
+// roundingModes returns every valid rounding mode.
+func roundingModes() []safe.RoundingMode {
+	return []safe.RoundingMode{
+		safe.RoundDown, safe.RoundUp, safe.RoundFloor, safe.RoundCeiling, safe.RoundHalfUp, safe.RoundHalfEven,
+	}
+}
+
+// referenceRound is the reference implementation of rounding num / den according to mode.
+func referenceRound(num, den *big.Int, mode safe.RoundingMode) *big.Int {
+	q, r := new(big.Int).QuoRem(num, den, new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+
+	neg := (num.Sign() < 0) != (den.Sign() < 0)
+	half := new(big.Int).Lsh(new(big.Int).Abs(r), 1).Cmp(new(big.Int).Abs(den))
+
+	var away bool
+	switch mode {
+	case safe.RoundDown:
+	case safe.RoundUp:
+		away = true
+	case safe.RoundFloor:
+		away = neg
+	case safe.RoundCeiling:
+		away = !neg
+	case safe.RoundHalfUp:
+		away = half >= 0
+	case safe.RoundHalfEven:
+		away = half > 0 || (half == 0 && q.Bit(0) == 1)
+	}
+
+	if !away {
+		return q
+	}
+
+	if neg {
+		return q.Sub(q, big.NewInt(1))
+	}
+
+	return q.Add(q, big.NewInt(1))
+}
+
+// referenceArithmetic is the reference implementation of checked arithmetic in T: it returns
+// num / den rounded according to mode when the divisor is non-zero and the result fits in T.
+func referenceArithmetic[T safe.Integer](num, den *big.Int, mode safe.RoundingMode) (*big.Int, errorClass) {
+	if den.Sign() == 0 {
+		return nil, classDivisionByZero
+	}
+
+	minValue, maxValue := safeconversiontest.Bounds[T]()
+
+	return referenceRange(referenceRound(num, den, mode), minValue, maxValue)
+}
+
+// divRoundDifferential checks DivRound with every rounding mode, called with a and b truncated to T.
+func divRoundDifferential[T safe.Integer](name string) differential {
+	return differential{name: name, check: func(t *testing.T, a, b, _ uint64) {
+		t.Helper()
+
+		x, y := T(a), T(b)
+		for _, mode := range roundingModes() {
+			got, err := safe.DivRound(x, y, mode)
+			want, class := referenceArithmetic[T](safeconversiontest.ToBig(x), safeconversiontest.ToBig(y), mode)
+			assertAgreesArithmetic(t, name, [3]any{x, y, mode}, safeconversiontest.ToBig(got), err, want, class)
+		}
+	}}
+}
+
+// mulDivRoundDifferential checks MulDivRound with every rounding mode, called with a, b and c
+// truncated to T.
+func mulDivRoundDifferential[T safe.Integer](name string) differential {
+	return differential{name: name, check: func(t *testing.T, a, b, c uint64) {
+		t.Helper()
+
+		x, y, z := T(a), T(b), T(c)
+		product := new(big.Int).Mul(safeconversiontest.ToBig(x), safeconversiontest.ToBig(y))
+
+		for _, mode := range roundingModes() {
+			got, err := safe.MulDivRound(x, y, z, mode)
+			want, class := referenceArithmetic[T](product, safeconversiontest.ToBig(z), mode)
+			assertAgreesArithmetic(t, name, [4]any{x, y, z, mode}, safeconversiontest.ToBig(got), err, want, class)
+		}
+	}}
+}
+
+// scaleCheckedDifferential checks ScaleChecked, called with a, b and c truncated to T.
+func scaleCheckedDifferential[T safe.Integer](name string) differential {
+	return differential{name: name, check: func(t *testing.T, a, b, c uint64) {
+		t.Helper()
+
+		v, num, den := T(a), T(b), T(c)
+		got, err := safe.ScaleChecked(v, num, den)
+
+		product := new(big.Int).Mul(safeconversiontest.ToBig(v), safeconversiontest.ToBig(num))
+		want, class := referenceArithmetic[T](product, safeconversiontest.ToBig(den), safe.RoundDown)
+		assertAgreesArithmetic(t, name, [3]any{v, num, den}, safeconversiontest.ToBig(got), err, want, class)
+	}}
+}
+
+// mulDivDifferential checks MulDiv, called with a, b and c.
+func mulDivDifferential() differential {
+	return differential{name: "MulDiv", check: func(t *testing.T, a, b, c uint64) {
+		t.Helper()
+
+		got, err := safe.MulDiv(a, b, c)
+
+		product := new(big.Int).Mul(new(big.Int).SetUint64(a), new(big.Int).SetUint64(b))
+		want, class := referenceArithmetic[uint64](product, new(big.Int).SetUint64(c), safe.RoundDown)
+		assertAgreesArithmetic(t, "MulDiv", [3]uint64{a, b, c}, new(big.Int).SetUint64(got), err, want, class)
+	}}
+}
+
+// decimalOf returns the decimal with mantissa m and a scale of at most MaxDecimalScale taken from s.
+func decimalOf(m, s uint64) safe.Decimal {
+	return safe.Decimal{Mantissa: int64(m), Scale: uint8(s % (safe.MaxDecimalScale + 1))} //nolint:gosec // the fuzz input is reinterpreted as a mantissa
+}
+
+// pow10Of returns 10^n as a *big.Int.
+func pow10Of(n uint8) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// decimalRescaleDifferential checks Decimal.Rescale with every rounding mode, called with the
+// decimal of a and c, and a target scale of at most MaxDecimalScale+1 taken from bits 8 and up of c.
+func decimalRescaleDifferential() differential {
+	return differential{name: "Decimal.Rescale", check: func(t *testing.T, a, _, c uint64) {
+		t.Helper()
+
+		d := decimalOf(a, c)
+		scale := uint8((c >> 8) % (safe.MaxDecimalScale + 2)) //nolint:gosec // the remainder is at most MaxDecimalScale+1
+
+		for _, mode := range roundingModes() {
+			got, err := d.Rescale(scale, mode)
+
+			var (
+				want  *big.Int
+				class = classOutOfRange
+			)
+
+			if scale <= safe.MaxDecimalScale {
+				num := new(big.Int).Mul(big.NewInt(d.Mantissa), pow10Of(scale))
+				want, class = referenceArithmetic[int64](num, pow10Of(d.Scale), mode)
+			}
+
+			assertDecimal(t, "Decimal.Rescale", [3]any{d, scale, mode}, got, scale, err, want, class)
+		}
+	}}
+}
+
+// decimalMulDifferential checks Decimal.Mul with every rounding mode, called with the decimals
+// of a and c, and of b and bits 8 and up of c.
+func decimalMulDifferential() differential {
+	return differential{name: "Decimal.Mul", check: func(t *testing.T, a, b, c uint64) {
+		t.Helper()
+
+		d, o := decimalOf(a, c), decimalOf(b, c>>8)
+		num := new(big.Int).Mul(big.NewInt(d.Mantissa), big.NewInt(o.Mantissa))
+
+		for _, mode := range roundingModes() {
+			got, err := d.Mul(o, mode)
+			want, class := referenceArithmetic[int64](num, pow10Of(o.Scale), mode)
+			assertDecimal(t, "Decimal.Mul", [3]any{d, o, mode}, got, d.Scale, err, want, class)
+		}
+	}}
+}
+
+// decimalDivDifferential checks Decimal.Div with every rounding mode, called with the decimals
+// of a and c, and of b and bits 8 and up of c.
+func decimalDivDifferential() differential {
+	return differential{name: "Decimal.Div", check: func(t *testing.T, a, b, c uint64) {
+		t.Helper()
+
+		d, o := decimalOf(a, c), decimalOf(b, c>>8)
+		num := new(big.Int).Mul(big.NewInt(d.Mantissa), pow10Of(o.Scale))
+
+		for _, mode := range roundingModes() {
+			got, err := d.Div(o, mode)
+			want, class := referenceArithmetic[int64](num, big.NewInt(o.Mantissa), mode)
+			assertDecimal(t, "Decimal.Div", [3]any{d, o, mode}, got, d.Scale, err, want, class)
+		}
+	}}
+}
+
+// assertDecimal asserts that the result and error of a decimal operation agree with the oracle,
+// and that a successful result has the expected scale.
+func assertDecimal(t *testing.T, name string, input any, got safe.Decimal, scale uint8, err error, want *big.Int, class errorClass) {
+	t.Helper()
+
+	if err == nil {
+		assert.Equalf(t, scale, got.Scale, "%s(%v)", name, input)
+	}
+
+	assertAgreesArithmetic(t, name, input, big.NewInt(got.Mantissa), err, want, class)
+}
+
+// decimalAlignedDifferential checks Decimal.Add or Decimal.Sub, called with the decimals of a and c,
+// and of b and bits 8 and up of c. The reference aligns both mantissas to the larger scale with
+// math/big and applies op.
+func decimalAlignedDifferential(name string, fn func(safe.Decimal, safe.Decimal) (safe.Decimal, error), op func(z, x, y *big.Int) *big.Int) differential {
+	return differential{name: name, check: func(t *testing.T, a, b, c uint64) {
+		t.Helper()
+
+		d, o := decimalOf(a, c), decimalOf(b, c>>8)
+		got, err := fn(d, o)
+
+		scale := max(d.Scale, o.Scale)
+		x := new(big.Int).Mul(big.NewInt(d.Mantissa), pow10Of(scale-d.Scale))
+		y := new(big.Int).Mul(big.NewInt(o.Mantissa), pow10Of(scale-o.Scale))
+
+		want, class := referenceArithmetic[int64](op(new(big.Int), x, y), big.NewInt(1), safe.RoundDown)
+		assertDecimal(t, name, [2]any{d, o}, got, scale, err, want, class)
+	}}
+}
+
+// decimalToInt64Differential checks Decimal.ToInt64 with every rounding mode, called with the decimal of a and c.
+func decimalToInt64Differential() differential {
+	return differential{name: "Decimal.ToInt64", check: func(t *testing.T, a, _, c uint64) {
+		t.Helper()
+
+		d := decimalOf(a, c)
+		for _, mode := range roundingModes() {
+			got, err := d.ToInt64(mode)
+			want, class := referenceArithmetic[int64](big.NewInt(d.Mantissa), pow10Of(d.Scale), mode)
+			assertAgreesArithmetic(t, "Decimal.ToInt64", [2]any{d, mode}, big.NewInt(got), err, want, class)
+		}
+	}}
+}
+
+// newDecimalDifferential checks NewDecimal, called with mantissa a and the scale of the low byte of c.
+func newDecimalDifferential() differential {
+	return differential{name: "NewDecimal", check: func(t *testing.T, a, _, c uint64) {
+		t.Helper()
+
+		mantissa, scale := int64(a), uint8(c) //nolint:gosec // the fuzz input is reinterpreted and truncated on purpose
+		got, err := safe.NewDecimal(mantissa, scale)
+
+		class := classNone
+		if scale > safe.MaxDecimalScale {
+			class = classOutOfRange
+		}
+
+		assertDecimal(t, "NewDecimal", [2]any{mantissa, scale}, got, scale, err, big.NewInt(mantissa), class)
+	}}
+}
+
+// decimalSyntax matches the syntax ParseDecimal accepts: an optional sign, the integer digits and
+// an optional fraction of at least one digit.
+var decimalSyntax = regexp.MustCompile(`^[+-]?([0-9]*)(?:\.([0-9]+))?$`) //nolint:gochecknoglobals // compiled once for the ParseDecimal reference
+
+// referenceDecimal is the reference implementation of ParseDecimal. It returns the mantissa and
+// scale of s, failing if s has no digits, has more than MaxDecimalScale fractional digits, or its
+// mantissa is outside the int64 range.
+func referenceDecimal(s string) (*big.Int, uint8, errorClass) {
+	match := decimalSyntax.FindStringSubmatch(s)
+	if match == nil || len(match[1])+len(match[2]) == 0 {
+		return nil, 0, classInvalidSyntax
+	}
+
+	if len(match[2]) > safe.MaxDecimalScale {
+		return nil, 0, classOutOfRange
+	}
+
+	mantissa, _ := new(big.Int).SetString(match[1]+match[2], 10)
+	if strings.HasPrefix(s, "-") {
+		mantissa.Neg(mantissa)
+	}
+
+	want, class := referenceInteger[int64](mantissa)
+
+	return want, uint8(len(match[2])), class //nolint:gosec // the scale is at most MaxDecimalScale
+}
+
+// decimalStrings returns the strings the ParseDecimal differential is called with: the digits of a
+// with a decimal point c%22 digits from the end and the sign selected by bits 8 and up of c, the
+// digits of a and b joined, a as a signed integer, and malformed variants of it.
+func decimalStrings(a, b, c uint64) []string {
+	digits := strconv.FormatUint(a, 10)
+	if point := int(c % 22); point > 0 {
+		digits = strings.Repeat("0", max(0, point-len(digits))) + digits
+		digits = digits[:len(digits)-point] + "." + digits[len(digits)-point:]
+	}
+
+	sign := [...]string{"", "-", "+"}[(c>>8)%3]
+	signed := strconv.FormatInt(int64(a), 10) //nolint:gosec // the fuzz input is reinterpreted as a signed value
+
+	return []string{
+		sign + digits, sign + strconv.FormatUint(a, 10) + strconv.FormatUint(b, 10), signed,
+		signed + ".", signed + "x", signed + "e1", "-" + signed, "0x" + signed, " " + signed,
+		"", "-", "+", ".", "+.", "-.5",
+	}
+}
+
+// parseDecimalDifferential checks ParseDecimal, called with the decimal strings of a, b and c.
+func parseDecimalDifferential() differential {
+	return differential{name: "ParseDecimal", check: func(t *testing.T, a, b, c uint64) {
+		t.Helper()
+
+		for _, s := range decimalStrings(a, b, c) {
+			got, err := safe.ParseDecimal(s)
+			want, scale, class := referenceDecimal(s)
+			assertDecimal(t, "ParseDecimal", s, got, scale, err, want, class)
+		}
+	}}
+}
+
+// wideInputs returns the values the 128-bit and 256-bit differentials are called with: the
+// 128-bit value of a and b, shifted to fill 192 and 256 bits and by c%256 bits, together with
+// their neighbors and negations.
+func wideInputs(a, b, c uint64) []*big.Int {
+	v := new(big.Int).Or(new(big.Int).Lsh(new(big.Int).SetUint64(a), 64), new(big.Int).SetUint64(b))
+	bases := []*big.Int{
+		v,
+		new(big.Int).Lsh(v, 64),
+		new(big.Int).Or(new(big.Int).Lsh(v, 128), v),
+		new(big.Int).Lsh(v, uint(c%256)),
+	}
+
+	inputs := make([]*big.Int, 0, 6*len(bases))
+	for _, base := range bases {
+		for _, delta := range []int64{-1, 0, 1} {
+			value := new(big.Int).Add(base, big.NewInt(delta))
+			inputs = append(inputs, value, new(big.Int).Neg(value))
+		}
+	}
+
+	return inputs
+}
+
+// referenceWide is the reference implementation of converting value to an integer of the given
+// width and signedness.
+func referenceWide(value *big.Int, bits uint, signed bool) (*big.Int, errorClass) {
+	one := big.NewInt(1)
+	if !signed {
+		return referenceRange(value, new(big.Int), new(big.Int).Sub(new(big.Int).Lsh(one, bits), one))
+	}
+
+	limit := new(big.Int).Lsh(one, bits-1)
+
+	return referenceRange(value, new(big.Int).Neg(limit), new(big.Int).Sub(limit, one))
+}
+
+// fromBigDifferential checks a conversion from *big.Int to a 128-bit or 256-bit type of the
+// given width and signedness, called with the wide inputs of a, b and c.
+func fromBigDifferential[W any](name string, fn func(*big.Int) (W, error), toBig func(W) *big.Int, bits uint, signed bool) differential {
+	return differential{name: name, check: func(t *testing.T, a, b, c uint64) {
+		t.Helper()
+
+		for _, value := range wideInputs(a, b, c) {
+			got, err := fn(value)
+			want, class := referenceWide(value, bits, signed)
+			assertAgrees(t, name, value, toBig(got), err, want, class)
+		}
+	}}
+}
+
+// newWideDifferential checks a conversion from S to a 128-bit or 256-bit type, called with a
+// truncated to S.
+func newWideDifferential[S safe.Integer, W any](name string, fn func(S) (W, error), toBig func(W) *big.Int, bits uint, signed bool) differential {
+	return differential{name: name, check: func(t *testing.T, a, _, _ uint64) {
+		t.Helper()
+
+		value := S(a)
+		got, err := fn(value)
+		want, class := referenceWide(safeconversiontest.ToBig(value), bits, signed)
+		assertAgrees(t, name, value, toBig(got), err, want, class)
+	}}
+}
+
+// newInt128Differential checks NewInt128, which always succeeds, called with a truncated to S.
+func newInt128Differential[S safe.Integer](name string) differential {
+	return differential{name: name, check: func(t *testing.T, a, _, _ uint64) {
+		t.Helper()
+
+		value := S(a)
+		assert.Equalf(t, safeconversiontest.ToBig(value).String(), safe.NewInt128(value).Big().String(), "%s(%d)", name, value)
+	}}
+}
+
+// uint128ToDifferential checks Uint128To, called with the Uint128 of a and b.
+func uint128ToDifferential[D safe.Integer](name string) differential {
+	return differential{name: name, check: func(t *testing.T, a, b, _ uint64) {
+		t.Helper()
+
+		value := safe.Uint128{Hi: a, Lo: b}
+		got, err := safe.Uint128To[D](value)
+		want, class := referenceInteger[D](value.Big())
+		assertAgrees(t, name, value, safeconversiontest.ToBig(got), err, want, class)
+	}}
+}
+
+// int128ToDifferential checks Int128To, called with the Int128 of a and b.
+func int128ToDifferential[D safe.Integer](name string) differential {
+	return differential{name: name, check: func(t *testing.T, a, b, _ uint64) {
+		t.Helper()
+
+		value := safe.Int128{Hi: int64(a), Lo: b} //nolint:gosec // the fuzz input is reinterpreted as the signed high limb
+		got, err := safe.Int128To[D](value)
+
+		// The reference value is Hi*2^64 + Lo, computed independently of Int128.Big.
+		reference := new(big.Int).Lsh(big.NewInt(value.Hi), 64)
+		reference.Add(reference, new(big.Int).SetUint64(value.Lo))
+
+		want, class := referenceInteger[D](reference)
+		assertAgrees(t, name, value, safeconversiontest.ToBig(got), err, want, class)
+	}}
+}
+
+// uint256Of returns the Uint256 with the 64-bit limbs of words, least significant first, as a *big.Int.
+func uint256Of(words ...uint64) *big.Int {
+	value := new(big.Int)
+	for i, word := range words {
+		value.Or(value, new(big.Int).Lsh(new(big.Int).SetUint64(word), uint(64*i)))
+	}
+
+	return value
+}
+
+// uint256BytesDifferential checks Uint256FromBytesBE and Uint256FromBytesLE, called with the
+// bytes of a, b, c and a. The reference decodes with math/big.
+func uint256BytesDifferential(name string, fn func([32]byte) safe.Uint256, order binary.ByteOrder) differential {
+	return differential{name: name, check: func(t *testing.T, a, b, c uint64) {
+		t.Helper()
+
+		var input [32]byte
+		for i, word := range []uint64{a, b, c, a} {
+			binary.BigEndian.PutUint64(input[8*i:], word)
+		}
+
+		be := input
+		if order == binary.LittleEndian {
+			slices.Reverse(be[:])
+		}
+
+		assert.Equalf(t, new(big.Int).SetBytes(be[:]).String(), fn(input).Big().String(), "%s(%x)", name, input)
+	}}
+}
+
+// uint256Uint64Differential checks Uint256.Uint64, called with the Uint256 of a and b in the
+// lowest limb and one other limb.
+func uint256Uint64Differential() differential {
+	return differential{name: "Uint256.Uint64", check: func(t *testing.T, a, b, c uint64) {
+		t.Helper()
+
+		for _, value := range []safe.Uint256{{a, 0, 0, 0}, {a, b, 0, 0}, {a, 0, 0, c}} {
+			got, err := value.Uint64()
+			want, class := referenceInteger[uint64](uint256Of(value[:]...))
+			assertAgrees(t, "Uint256.Uint64", value, new(big.Int).SetUint64(got), err, want, class)
+		}
+	}}
+}
+
+// compactDifferential checks Uint256FromCompact, called with the low 32 bits of a and with the
+// mantissa of b at every exponent up to 35. The reference decodes the mantissa and exponent
+// with math/big.
+func compactDifferential() differential {
+	return differential{name: "Uint256FromCompact", check: func(t *testing.T, a, b, _ uint64) {
+		t.Helper()
+
+		inputs := []uint32{uint32(a), uint32(a >> 32)} //nolint:gosec // the compact value is taken from the fuzz input bits
+		for exponent := range uint32(36) {
+			inputs = append(inputs, exponent<<24|uint32(b)&0xffffff) //nolint:gosec // the mantissa is taken from the fuzz input bits
+		}
+
+		for _, compact := range inputs {
+			got, err := safe.Uint256FromCompact(compact)
+
+			exponent := int(compact >> 24)
+			mantissa := big.NewInt(int64(compact & 0x7fffff))
+
+			want, class := new(big.Int), classNone
+			switch {
+			case mantissa.Sign() != 0 && compact&0x800000 != 0:
+				class = classNegative
+			case exponent <= 3:
+				want.Rsh(mantissa, uint(8*(3-exponent)))
+			default:
+				want, class = referenceWide(new(big.Int).Lsh(mantissa, uint(8*(exponent-3))), 256, false)
+			}
+
+			assertAgrees(t, "Uint256FromCompact", compact, got.Big(), err, want, class)
+		}
+	}}
+}
+
+// wideArithmeticDifferentials returns the differential cases of the checked arithmetic of the
+// 128-bit and 256-bit types.
+func wideArithmeticDifferentials() []differential {
+	neg := func(z, x, _ *big.Int) *big.Int { return z.Neg(x) }
+
+	return []differential{
+		wideArithmeticDifferential("Uint128.Add", safe.Uint128.Add, (*big.Int).Add, safe.Uint128FromBig, safe.Uint128.Big, 128, false),
+		wideArithmeticDifferential("Uint128.Sub", safe.Uint128.Sub, (*big.Int).Sub, safe.Uint128FromBig, safe.Uint128.Big, 128, false),
+		wideArithmeticDifferential("Uint128.Mul", safe.Uint128.Mul, (*big.Int).Mul, safe.Uint128FromBig, safe.Uint128.Big, 128, false),
+		wideArithmeticDifferential("Int128.Add", safe.Int128.Add, (*big.Int).Add, safe.Int128FromBig, safe.Int128.Big, 128, true),
+		wideArithmeticDifferential("Int128.Sub", safe.Int128.Sub, (*big.Int).Sub, safe.Int128FromBig, safe.Int128.Big, 128, true),
+		wideArithmeticDifferential("Int128.Mul", safe.Int128.Mul, (*big.Int).Mul, safe.Int128FromBig, safe.Int128.Big, 128, true),
+		wideArithmeticDifferential("Int128.Neg", func(i, _ safe.Int128) (safe.Int128, error) { return i.Neg() }, neg, safe.Int128FromBig, safe.Int128.Big, 128, true),
+		wideArithmeticDifferential("Uint256.Add", safe.Uint256.Add, (*big.Int).Add, safe.Uint256FromBig, safe.Uint256.Big, 256, false),
+		wideArithmeticDifferential("Uint256.Sub", safe.Uint256.Sub, (*big.Int).Sub, safe.Uint256FromBig, safe.Uint256.Big, 256, false),
+		wideArithmeticDifferential("Uint256.Mul", safe.Uint256.Mul, (*big.Int).Mul, safe.Uint256FromBig, safe.Uint256.Big, 256, false),
+		wideMethodDifferential("Uint128.Uint64", safe.Uint128.Uint64, safe.Uint128FromBig, safeconversiontest.ToBig[uint64], 64, false),
+		wideMethodDifferential("Uint128.Int128", safe.Uint128.Int128, safe.Uint128FromBig, safe.Int128.Big, 128, true),
+		wideMethodDifferential("Int128.Int64", safe.Int128.Int64, safe.Int128FromBig, safeconversiontest.ToBig[int64], 64, true),
+		wideMethodDifferential("Int128.Uint128", safe.Int128.Uint128, safe.Int128FromBig, safe.Uint128.Big, 128, false),
+		div64Differential(),
+	}
+}
+
+// wideArithmeticDifferential checks an operation of a 128-bit or 256-bit type of the given width
+// and signedness, called with pairs of the wide inputs of a, b and c, and of b, c and a, skipping
+// operands outside the range of the type. The reference applies op with math/big.
+func wideArithmeticDifferential[W any](
+	name string, fn func(W, W) (W, error), op func(z, x, y *big.Int) *big.Int,
+	fromBig func(*big.Int) (W, error), toBig func(W) *big.Int, bits uint, signed bool,
+) differential {
+	return differential{name: name, check: func(t *testing.T, a, b, c uint64) {
+		t.Helper()
+
+		xs, ys := wideInputs(a, b, c), wideInputs(b, c, a)
+		for i, x := range xs {
+			for _, y := range []*big.Int{ys[i], ys[len(ys)-1-i]} {
+				u, errU := fromBig(x)
+				v, errV := fromBig(y)
+
+				if errU != nil || errV != nil {
+					continue
+				}
+
+				got, err := fn(u, v)
+				want, class := referenceWide(op(new(big.Int), x, y), bits, signed)
+				assertAgreesArithmetic(t, name, [2]*big.Int{x, y}, toBig(got), err, want, class)
+			}
+		}
+	}}
+}
+
+// wideMethodDifferential checks a conversion method of a 128-bit type to a type of the given width
+// and signedness, called with the wide inputs of a, b and c in the range of the receiver.
+func wideMethodDifferential[W, D any](name string, fn func(W) (D, error), fromBig func(*big.Int) (W, error), toBig func(D) *big.Int, bits uint, signed bool) differential {
+	return differential{name: name, check: func(t *testing.T, a, b, c uint64) {
+		t.Helper()
+
+		for _, value := range wideInputs(a, b, c) {
+			receiver, err := fromBig(value)
+			if err != nil {
+				continue
+			}
+
+			got, err := fn(receiver)
+			want, class := referenceWide(value, bits, signed)
+			assertAgrees(t, name, value, toBig(got), err, want, class)
+		}
+	}}
+}
+
+// div64Differential checks Uint128.Div64, called with the Uint128 of a and b and the divisor c.
+func div64Differential() differential {
+	return differential{name: "Uint128.Div64", check: func(t *testing.T, a, b, c uint64) {
+		t.Helper()
+
+		value := safe.Uint128{Hi: a, Lo: b}
+		got, remainder, err := value.Div64(c)
+
+		want, wantRemainder, class := new(big.Int), new(big.Int), classDivisionByZero
+		if c != 0 {
+			want.QuoRem(uint256Of(b, a), new(big.Int).SetUint64(c), wantRemainder)
+			class = classNone
+		}
+
+		assertAgreesArithmetic(t, "Uint128.Div64", [2]any{value, c}, got.Big(), err, want, class)
+		assert.Equalf(t, wantRemainder.Uint64(), remainder, "Uint128.Div64(%v, %d): remainder", value, c)
+	}}
+}
+
+// referenceParse is the reference implementation of parsing s in the given base as a T, with the
+// syntax of math/big.
+func referenceParse[T safe.Integer](s string, base int) (*big.Int, errorClass) {
+	value, ok := new(big.Int).SetString(s, base)
+	if !ok {
+		return nil, classInvalidSyntax
+	}
+
+	return referenceInteger[T](value)
+}
+
+// integerStrings returns the strings the parsing differentials are called with: a as a signed and
+// an unsigned integer in base 10, with an explicit sign, with a base prefix and with an underscore,
+// and malformed variants.
+func integerStrings(a uint64) []string {
+	signed := strconv.FormatInt(int64(a), 10) //nolint:gosec // the fuzz input is reinterpreted as a signed value
+	unsigned := strconv.FormatUint(a, 10)
+	hex := strconv.FormatUint(a, 16)
+
+	return []string{
+		signed, unsigned, "+" + unsigned, "-" + unsigned, "0" + unsigned, unsigned[:1] + "_" + unsigned[1:],
+		"0x" + hex, "-0X" + hex, "0x_" + hex, "0b" + strconv.FormatUint(a, 2), "0o" + strconv.FormatUint(a, 8),
+		"0" + strconv.FormatUint(a, 8), signed + "x", "--" + unsigned, "0x", "", "+", "-",
+	}
+}
+
+// boundedSetDifferential checks Bounded.Set, called with the integer strings of a, without a custom
+// range and with the range between b and c truncated to T. A failed Set must leave the value unchanged.
+func boundedSetDifferential[T safe.Integer](name string) differential {
+	return differential{name: name, check: func(t *testing.T, a, b, c uint64) {
+		t.Helper()
+
+		lo, hi := min(T(b), T(c)), max(T(b), T(c))
+		for _, s := range integerStrings(a) {
+			for _, r := range []*safe.Range[T]{nil, {Min: lo, Max: hi}} {
+				bounded := safe.NewBounded(lo, r)
+				err := bounded.Set(s)
+
+				want, class := referenceParse[T](s, 0)
+				if class == classNone && r != nil {
+					want, class = referenceRange(want, safeconversiontest.ToBig(lo), safeconversiontest.ToBig(hi))
+				}
+
+				input := [2]any{s, r != nil}
+				if assertClass(t, name, input, err, class) {
+					assert.Equalf(t, want.String(), safeconversiontest.ToBig(bounded.Value).String(), "%s(%v)", name, input)
+				} else {
+					assert.Equalf(t, lo, bounded.Value, "%s(%v): failed Set changed the value", name, input)
+				}
+			}
+		}
+	}}
+}
+
+// rangeCheckDifferential checks Range.Check, called with a truncated to T and the range from b to c
+// truncated to T, which is empty when b is above c.
+func rangeCheckDifferential[T safe.Integer](name string) differential {
+	return differential{name: name, check: func(t *testing.T, a, b, c uint64) {
+		t.Helper()
+
+		value, r := T(a), safe.Range[T]{Min: T(b), Max: T(c)}
+		err := r.Check(value)
+		_, class := referenceRange(safeconversiontest.ToBig(value), safeconversiontest.ToBig(r.Min), safeconversiontest.ToBig(r.Max))
+		requireClass(t, name, [2]any{value, r}, err, class)
+	}}
+}
+
+// scanDifferential checks the Scan method of a database/sql type holding D, called with a as an
+// int64, an uint64 and the float64 of bits a, and with the integer strings of a as text and bytes.
+// The reference parses text in base 10. A nullable type is also called with nil, and must be valid
+// exactly when the scan succeeds.
+func scanDifferential[D safe.Integer](name string, scan func(src any) (D, bool, error), nullable bool) differential {
+	return differential{name: name, check: func(t *testing.T, a, _, _ uint64) {
+		t.Helper()
+
+		if nullable {
+			got, valid, err := scan(nil)
+			require.NoErrorf(t, err, "%s(nil)", name)
+			assert.Falsef(t, valid, "%s(nil): NULL is valid", name)
+			assert.Zerof(t, got, "%s(nil)", name)
+		}
+
+		sources := []any{int64(a), a, math.Float64frombits(a)} //nolint:gosec // the fuzz input is reinterpreted as a signed value
+		for _, s := range integerStrings(a) {
+			sources = append(sources, s, []byte(s))
+		}
+
+		for _, src := range sources {
+			got, valid, err := scan(src)
+
+			var (
+				want  *big.Int
+				class errorClass
+			)
+
+			switch v := src.(type) {
+			case int64:
+				want, class = referenceInteger[D](big.NewInt(v))
+			case uint64:
+				want, class = referenceInteger[D](new(big.Int).SetUint64(v))
+			case float64:
+				want, class = referenceFloatInteger[D](v)
+			case string:
+				want, class = referenceParse[D](v, 10)
+			case []byte:
+				want, class = referenceParse[D](string(v), 10)
+			}
+
+			assertAgrees(t, name, src, safeconversiontest.ToBig(got), err, want, class)
+			if nullable {
+				assert.Equalf(t, class == classNone, valid, "%s(%v): validity", name, src)
+			}
+		}
+	}}
+}
+
+// valueDifferential checks the Value method of a database/sql type holding D, called with a
+// truncated to D. The reference requires the value to fit in the int64 of a driver.Value.
+func valueDifferential[D safe.Integer](name string, value func(D) (driver.Value, error)) differential {
+	return differential{name: name, check: func(t *testing.T, a, _, _ uint64) {
+		t.Helper()
+
+		v := D(a)
+		got, err := value(v)
+		n, _ := got.(int64)
+		want, class := referenceInteger[int64](safeconversiontest.ToBig(v))
+		assertAgrees(t, name, v, big.NewInt(n), err, want, class)
+	}}
+}
+
+// sqlDifferentials returns the differential cases of the database/sql types.
+func sqlDifferentials() []differential {
+	return []differential{
+		scanDifferential("SQLUint16.Scan", func(src any) (uint16, bool, error) {
+			var u safe.SQLUint16
+			err := u.Scan(src)
+			return uint16(u), true, err
+		}, false),
+		scanDifferential("SQLUint32.Scan", func(src any) (uint32, bool, error) {
+			var u safe.SQLUint32
+			err := u.Scan(src)
+			return uint32(u), true, err
+		}, false),
+		scanDifferential("SQLUint64.Scan", func(src any) (uint64, bool, error) {
+			var u safe.SQLUint64
+			err := u.Scan(src)
+			return uint64(u), true, err
+		}, false),
+		scanDifferential("NullUint16.Scan", func(src any) (uint16, bool, error) {
+			var n safe.NullUint16
+			err := n.Scan(src)
+			return n.Uint16, n.Valid, err
+		}, true),
+		scanDifferential("NullUint32.Scan", func(src any) (uint32, bool, error) {
+			var n safe.NullUint32
+			err := n.Scan(src)
+			return n.Uint32, n.Valid, err
+		}, true),
+		scanDifferential("NullUint64.Scan", func(src any) (uint64, bool, error) {
+			var n safe.NullUint64
+			err := n.Scan(src)
+			return n.Uint64, n.Valid, err
+		}, true),
+		valueDifferential("SQLUint16.Value", func(v uint16) (driver.Value, error) { return safe.SQLUint16(v).Value() }),
+		valueDifferential("SQLUint32.Value", func(v uint32) (driver.Value, error) { return safe.SQLUint32(v).Value() }),
+		valueDifferential("SQLUint64.Value", func(v uint64) (driver.Value, error) { return safe.SQLUint64(v).Value() }),
+		valueDifferential("NullUint16.Value", func(v uint16) (driver.Value, error) { return safe.NullUint16{Uint16: v, Valid: true}.Value() }),
+		valueDifferential("NullUint32.Value", func(v uint32) (driver.Value, error) { return safe.NullUint32{Uint32: v, Valid: true}.Value() }),
+		valueDifferential("NullUint64.Value", func(v uint64) (driver.Value, error) { return safe.NullUint64{Uint64: v, Valid: true}.Value() }),
+	}
+}
+
+// counterStoreDifferential checks CheckedCounter.Store, called with a truncated to T.
+func counterStoreDifferential[T safe.Integer](name string) differential {
+	return differential{name: name, check: func(t *testing.T, a, _, _ uint64) {
+		t.Helper()
+
+		var counter safe.CheckedCounter[T]
+
+		value := T(a)
+		err := counter.Store(value)
+
+		_, maxValue := safeconversiontest.Bounds[T]()
+		want, class := referenceRange(safeconversiontest.ToBig(value), new(big.Int), maxValue)
+		assertAgreesArithmetic(t, name, value, safeconversiontest.ToBig(counter.Load()), err, want, class)
+	}}
+}
+
+// counterUpdateDifferential checks CheckedCounter.Add or CheckedCounter.Sub, called on a counter
+// holding a truncated to T with the delta b truncated to T and its negation. Negative values of a,
+// which the counter cannot hold, are skipped. A failed update must leave the counter unchanged.
+func counterUpdateDifferential[T safe.Integer](name string, update func(*safe.CheckedCounter[T], T) (T, error), op func(z, x, y *big.Int) *big.Int) differential {
+	return differential{name: name, check: func(t *testing.T, a, b, _ uint64) {
+		t.Helper()
+
+		start := T(a)
+		if start < 0 {
+			return
+		}
+
+		_, maxValue := safeconversiontest.Bounds[T]()
+
+		for _, delta := range []T{T(b), -T(b)} {
+			var counter safe.CheckedCounter[T]
+			require.NoError(t, counter.Store(start))
+
+			got, err := update(&counter, delta)
+
+			result := op(new(big.Int), safeconversiontest.ToBig(start), safeconversiontest.ToBig(delta))
+			want, class := referenceRange(result, new(big.Int), maxValue)
+			assertAgreesArithmetic(t, name, [2]any{start, delta}, safeconversiontest.ToBig(got), err, want, class)
+
+			if err != nil {
+				assert.Equalf(t, start, counter.Load(), "%s(%d, %d): failed update changed the counter", name, start, delta)
+			} else {
+				assert.Equalf(t, got, counter.Load(), "%s(%d, %d): counter", name, start, delta)
+			}
+		}
+	}}
+}
+
+// differentialSeeds returns the inputs every differential is checked with: the boundaries
+// of the 64-bit types, and the boundaries of the rune and opcode ranges.
+func differentialSeeds() []uint64 {
+	seeds := safeconversiontest.BoundaryValues[uint64]()
+	for _, v := range safeconversiontest.BoundaryValues[int64]() {
+		if v < 0 {
+			seeds = append(seeds, uint64(v)) //nolint:gosec // negative seeds are passed as their bit pattern
+		}
+	}
+
+	minusTwo := int64(-2)
+
+	seeds = append(seeds, 16, 17, uint64(minusTwo), //nolint:gosec // negative seeds are passed as their bit pattern
+		0xD7FF, 0xD800, 0xDFFF, 0xE000, unicode.MaxRune, unicode.MaxRune+1, math.MaxUint32/2)
+
+	for _, f := range []float64{
+		1, -1, 0.5, math.Copysign(0, -1), 255, 256, -128, -129, 1 << 24, 1<<24 + 1, 1 << 63, 1e300,
+		math.MaxFloat32, math.MaxFloat32 * 2, math.SmallestNonzeroFloat32, math.SmallestNonzeroFloat32 / 2,
+		math.NaN(), math.Inf(1), math.Inf(-1), 0.1, -0.1, 1 + 0x1p-24, 1 + 0x1p-23 + 0x1p-24, 0x1p128, 0x1p128 - 0x1p103,
+		math.MaxFloat32 + 0x1p103, 0x1p-126, 0x1p-127 + 0x1p-150, 0x1p-150, -0x1p-150, 0x1p-149 * 1.5,
+	} {
+		seeds = append(seeds, math.Float64bits(f), uint64(math.Float32bits(float32(f))))
+	}
+
+	return seeds
+}
+
+// differentialInputs returns the argument triples built from the seeds.
+func differentialInputs() [][3]uint64 {
+	seeds := differentialSeeds()
+	inputs := make([][3]uint64, 0, 4*len(seeds))
+
+	for _, s := range seeds {
+		inputs = append(inputs, [3]uint64{s, 0, 0}, [3]uint64{0, s, 1}, [3]uint64{s, 1, s}, [3]uint64{s, s, 2})
+	}
+
+	// Byte encodings of one to three bytes around the sign boundaries, in both byte orders and
+	// both signed encodings.
+	edges := []uint64{0x00, 0x01, 0x7f, 0x80, 0x81, 0xff}
+	for _, first := range edges {
+		for _, second := range edges {
+			for _, c := range []uint64{1, 2, 3, 0x102, 0x103, 0x202, 0x203, 0x302, 0x303} {
+				inputs = append(inputs, [3]uint64{first<<56 | second<<48 | first<<40, 0, c})
+			}
+		}
+	}
+
+	return inputs
+}
+
+// TestDifferential checks every conversion function against the reference implementation at the seeds.
+func TestDifferential(t *testing.T) {
+	inputs := differentialInputs()
+
+	for _, d := range differentials() {
+		t.Run(d.name, func(t *testing.T) {
+			for _, in := range inputs {
+				d.check(t, in[0], in[1], in[2])
+			}
+		})
+	}
+}
+
+// TestDifferentialCoversConversions tests that every exported function and method of the package
+// has a differential, apart from those deliberately left out below.
+func TestDifferentialCoversConversions(t *testing.T) {
+	names := make(map[string]bool)
+	covered := make(map[string]bool)
+
+	for _, d := range differentials() {
+		assert.False(t, names[d.name], "duplicate differential %s", d.name)
+		names[d.name] = true
+
+		// Generic functions are checked with several instantiations, named like "ComplexTo[int64]".
+		fn, _, _ := strings.Cut(d.name, "[")
+		covered[fn] = true
+	}
+
+	// A name of the form "Type.*" leaves out every method of the type.
+	excluded := make(map[string]bool)
+	for _, name := range []string{
+		// Constructors, queries and encoders, which cannot fail.
+		"NewBatch", "NewBatchAll", "NewBounded", "NewExpvarObserver", "Bounded.Get", "CheckedCounter.Load",
+		"Decimal.Cmp", "Int128.Big", "Int128.Cmp", "Int128.Sign", "Uint128.Big", "Uint128.Cmp", "Uint128.IsZero",
+		"Uint256.Big", "Uint256.BitLen", "Uint256.BytesBE", "Uint256.BytesLE", "Uint256.Cmp", "Uint256.Compact",
+		"Uint256.IsZero",
+
+		// Formatting, and text unmarshaling, which forwards to Bounded.Set and ParseDecimal.
+		"Bounded.MarshalText", "Bounded.String", "Bounded.UnmarshalText", "Decimal.MarshalText", "Decimal.String",
+		"Decimal.UnmarshalText", "Int128.String", "Policy.String", "RoundingMode.String", "SignedEncoding.String",
+		"Uint128.String", "Uint256.String",
+
+		// The Batch and Policy methods and Must forward to the checked functions and only change how
+		// their errors are reported, which batch_test.go and policy_test.go test.
+		"Batch.*", "Policy.*", "Must",
+
+		// Error labeling and failure reporting.
+		"ConversionError.*", "ExpvarObserver.*", "FieldError.*", "Labeled", "SetObserver", "WithField",
+	} {
+		excluded[name] = true
+	}
+
+	paths, err := filepath.Glob("*.go")
+	require.NoError(t, err)
+
+	declared := make(map[string]bool)
+	fset := token.NewFileSet()
+
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, path, nil, parser.SkipObjectResolution)
+		require.NoError(t, err)
+
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || !fn.Name.IsExported() {
+				continue
+			}
+
+			name, wildcard := fn.Name.Name, ""
+			if fn.Recv != nil {
+				receiver := receiverName(fn.Recv.List[0].Type)
+				if !token.IsExported(receiver) {
+					continue
+				}
+
+				name, wildcard = receiver+"."+name, receiver+".*"
+			}
+
+			declared[name], declared[wildcard] = true, true
+			assert.True(t, covered[name] || excluded[name] || excluded[wildcard], "%s has no differential", name)
+			assert.False(t, covered[name] && excluded[name], "%s has a differential but is excluded", name)
+		}
+	}
+
+	for name := range excluded {
+		assert.True(t, declared[name], "excluded %s is not declared", name)
+	}
+}
+
+// receiverName returns the name of the type of a method receiver, without its pointer or type parameters.
+func receiverName(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.StarExpr:
+		return receiverName(e.X)
+	case *ast.IndexExpr:
+		return receiverName(e.X)
+	case *ast.IndexListExpr:
+		return receiverName(e.X)
+	case *ast.Ident:
+		return e.Name
+	default:
+		return ""
+	}
+}
+
+// FuzzDifferential checks every conversion function against the reference implementation with random inputs.
+func FuzzDifferential(f *testing.F) {
+	for _, in := range differentialInputs() {
+		f.Add(in[0], in[1], in[2])
+	}
+
+	f.Fuzz(func(t *testing.T, a, b, c uint64) {
+		for _, d := range differentials() {
+			d.check(t, a, b, c)
+		}
+	})
+}
@@ -0,0 +1,122 @@
+package safeconversion
+
+import (
+	"errors"
+	"log/slog"
+	"math"
+	"unicode"
+)
+
+// ConversionError describes a failed conversion. It is returned by the package-level
+// conversion functions and by the other functions that narrow a value, such as Scan,
+// Bounded.Set, Uint128To and Uint256FromBig. It wraps an error that matches one of the
+// package sentinels, so errors.Is keeps working. Min and Max hold the bounds of the
+// destination type, or nil when they are not known.
+type ConversionError struct {
+	From  string
+	To    string
+	Value any
+	Min   any
+	Max   any
+	Err   error
+}
+
+// newConversionError returns a ConversionError for converting value from src to dst.
+func newConversionError(src, dst string, value any, err error) *ConversionError {
+	minValue, maxValue := bounds(dst)
+
+	return &ConversionError{From: src, To: dst, Value: value, Min: minValue, Max: maxValue, Err: err}
+}
+
+// Error returns the message of the wrapped error.
+func (e *ConversionError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the wrapped error.
+func (e *ConversionError) Unwrap() error {
+	return e.Err
+}
+
+// Reason returns the message of the package sentinel that the error matches, such as
+// "value out of range", or an empty string if it matches none.
+func (e *ConversionError) Reason() string {
+	for _, sentinel := range []error{
+		ErrInvalidRune,
+		ErrNonRealValue,
+		ErrInexactValue,
+		ErrFractionalValue,
+		ErrNegativeValueCannotBeConverted,
+		ErrValueOutOfRange,
+		ErrValueOverflow,
+		ErrValueUnderflow,
+		ErrValueExceedsLimit,
+		ErrInvalidSyntax,
+		ErrUnsupportedType,
+		ErrDivisionByZero,
+		ErrInvalidRoundingMode,
+	} {
+		if errors.Is(e.Err, sentinel) {
+			return sentinel.Error()
+		}
+	}
+
+	return ""
+}
+
+// LogValue implements slog.LogValuer, so that logging the error emits the conversion
+// as structured attributes instead of a flat message.
+func (e *ConversionError) LogValue() slog.Value {
+	attrs := []slog.Attr{
+		slog.String("from_type", e.From),
+		slog.String("to_type", e.To),
+		slog.Any("value", e.Value),
+	}
+
+	if e.Min != nil && e.Max != nil {
+		attrs = append(attrs, slog.Any("min", e.Min), slog.Any("max", e.Max))
+	}
+
+	attrs = append(attrs, slog.String("reason", e.Reason()), slog.String("error", e.Error()))
+
+	return slog.GroupValue(attrs...)
+}
+
+// bounds returns the smallest and largest values of the named destination type,
+// or nil if the type is not known.
+func bounds(dst string) (any, any) {
+	switch dst {
+	case "int":
+		return math.MinInt, math.MaxInt
+	case "int8":
+		return int8(math.MinInt8), int8(math.MaxInt8)
+	case "int16":
+		return int16(math.MinInt16), int16(math.MaxInt16)
+	case "int32":
+		return int32(math.MinInt32), int32(math.MaxInt32)
+	case "int64":
+		return int64(math.MinInt64), int64(math.MaxInt64)
+	case "uint8", "byte":
+		return uint8(0), uint8(math.MaxUint8)
+	case "uint":
+		return uint(0), uint(math.MaxUint)
+	case "uint16":
+		return uint16(0), uint16(math.MaxUint16)
+	case "uint32":
+		return uint32(0), uint32(math.MaxUint32)
+	case "uint64":
+		return uint64(0), uint64(math.MaxUint64)
+	case "uintptr":
+		return uintptr(0), ^uintptr(0)
+	case "float32":
+		return float32(-math.MaxFloat32), float32(math.MaxFloat32)
+	case "float64":
+		return -math.MaxFloat64, math.MaxFloat64
+	case "rune":
+		return rune(0), rune(unicode.MaxRune)
+	case "opcode":
+		return -1, 16
+	default:
+		return nil, nil
+	}
+}
@@ -0,0 +1,149 @@
+package safeconversion_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	safe "github.com/bsv-blockchain/go-safe-conversion"
+)
+
+// logError logs err with a JSON handler and returns the decoded "err" attribute.
+func logError(t *testing.T, err error) map[string]any {
+	t.Helper()
+
+	var buf bytes.Buffer
+	slog.New(slog.NewJSONHandler(&buf, nil)).Error("decode failed", "err", err)
+
+	var record map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+
+	attrs, ok := record["err"].(map[string]any)
+	require.True(t, ok, buf.String())
+
+	return attrs
+}
+
+// TestConversionError tests the structured error returned by the conversion functions.
+func TestConversionError(t *testing.T) {
+	_, err := safe.Int64ToInt32(5000000000)
+	require.ErrorIs(t, err, safe.ErrValueOutOfRange)
+	assert.Equal(t, "value out of range (int32): 5000000000", err.Error())
+
+	var convErr *safe.ConversionError
+	require.ErrorAs(t, err, &convErr)
+	assert.Equal(t, "int64", convErr.From)
+	assert.Equal(t, "int32", convErr.To)
+	assert.Equal(t, int64(5000000000), convErr.Value)
+	assert.Equal(t, int32(math.MinInt32), convErr.Min)
+	assert.Equal(t, int32(math.MaxInt32), convErr.Max)
+	assert.Equal(t, "value out of range", convErr.Reason())
+
+	_, err = safe.IntToRune(0xD800)
+	require.ErrorAs(t, err, &convErr)
+	assert.Equal(t, "invalid rune", convErr.Reason())
+
+	convErr = &safe.ConversionError{Err: errors.New("other")} //nolint:err113 // an error that matches no sentinel
+	assert.Empty(t, convErr.Reason())
+}
+
+// TestConversionErrorNarrowing tests that the narrowing paths outside the conversion functions
+// return a ConversionError as well.
+func TestConversionErrorNarrowing(t *testing.T) {
+	var scanned safe.SQLUint16
+
+	tests := []struct {
+		name  string
+		err   func() error
+		from  string
+		to    string
+		value any
+	}{
+		{"sql scan", func() error { return scanned.Scan([]byte("70000")) }, "[]byte", "uint16", "70000"},
+		{"sql scan syntax", func() error { return scanned.Scan("abc") }, "string", "uint16", "abc"},
+		{"bytes syntax", func() error {
+			_, err := safe.BytesToUint64([]byte{0, 1}, binary.BigEndian)
+			return err
+		}, "[]byte", "uint64", []byte{0, 1}},
+		{"bounded set", func() error { return safe.NewBounded[uint8](0, nil).Set("-1") }, "string", "uint8", "-1"},
+		{"bounded set range", func() error {
+			return safe.NewBounded[uint16](0, &safe.Range[uint16]{Min: 1, Max: 10}).Set("20")
+		}, "string", "uint16", "20"},
+		{"uint128", func() error {
+			_, err := safe.Uint128To[uint32](safe.Uint128{Hi: 1})
+			return err
+		}, "uint128", "uint32", safe.Uint128{Hi: 1}},
+		{"int128", func() error {
+			_, err := safe.Int128To[uint64](safe.NewInt128(-1))
+			return err
+		}, "int128", "uint64", safe.NewInt128(-1)},
+		{"uint256", func() error {
+			_, err := safe.Uint256{0, 1}.Uint64()
+			return err
+		}, "uint256", "uint64", safe.Uint256{0, 1}},
+		{"big.Int", func() error {
+			_, err := safe.Int128FromBig(new(big.Int).Lsh(big.NewInt(1), 127))
+			return err
+		}, "*big.Int", "int128", new(big.Int).Lsh(big.NewInt(1), 127)},
+		{"compact", func() error {
+			_, err := safe.Uint256FromCompact(0x04923456)
+			return err
+		}, "compact", "uint256", uint32(0x04923456)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var convErr *safe.ConversionError
+			require.ErrorAs(t, tt.err(), &convErr)
+			assert.Equal(t, tt.from, convErr.From)
+			assert.Equal(t, tt.to, convErr.To)
+			assert.Equal(t, tt.value, convErr.Value)
+			assert.NotEmpty(t, convErr.Reason())
+		})
+	}
+
+	var convErr *safe.ConversionError
+	require.ErrorAs(t, scanned.Scan("abc"), &convErr)
+	assert.Equal(t, safe.ErrInvalidSyntax.Error(), convErr.Reason())
+
+	// A custom range failure reports the custom range as its bounds.
+	require.ErrorAs(t, safe.NewBounded[uint16](0, &safe.Range[uint16]{Min: 1, Max: 10}).Set("20"), &convErr)
+	assert.Equal(t, uint16(1), convErr.Min)
+	assert.Equal(t, uint16(10), convErr.Max)
+}
+
+// TestConversionErrorLogValue tests the slog attributes of a conversion error.
+func TestConversionErrorLogValue(t *testing.T) {
+	_, err := safe.IntToUint16(-1)
+	attrs := logError(t, err)
+
+	assert.Equal(t, map[string]any{
+		"from_type": "int",
+		"to_type":   "uint16",
+		"value":     float64(-1),
+		"min":       float64(0),
+		"max":       float64(math.MaxUint16),
+		"reason":    "negative value cannot be converted to unsigned integer",
+		"error":     err.Error(),
+	}, attrs)
+}
+
+// TestFieldErrorLogValue tests that field labels are added to the slog attributes.
+func TestFieldErrorLogValue(t *testing.T) {
+	_, err := safe.Labeled("vout", safe.IntToUint32, -1)
+	attrs := logError(t, safe.WithField("tx", err))
+	assert.Equal(t, "tx.vout", attrs["field"])
+	assert.Equal(t, "int", attrs["from_type"])
+	assert.Equal(t, "value out of range", attrs["reason"])
+
+	attrs = logError(t, safe.WithField("vout", errors.New("plain"))) //nolint:err113 // an error without attributes
+	assert.Equal(t, map[string]any{"field": "vout", "error": "plain"}, attrs)
+}
@@ -0,0 +1,69 @@
+package safeconversion
+
+import (
+	"errors"
+	"log/slog"
+)
+
+// FieldError labels a conversion error with the field or input that failed, such as
+// "vout: value out of range (uint32): -1". The wrapped error is kept, so errors.Is still
+// matches the package sentinels, and errors.As can be used to recover the field name.
+type FieldError struct {
+	Field string
+	Err   error
+}
+
+// WithField labels err with field.
+// Returns nil if err is nil.
+func WithField(field string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &FieldError{Field: field, Err: err}
+}
+
+// Labeled applies the conversion fn to value and labels any error with field:
+//
+//	vout, err := Labeled("vout", IntToUint32, v)
+func Labeled[S, D any](field string, fn func(S) (D, error), value S) (D, error) {
+	result, err := fn(value)
+	if err != nil {
+		var zero D
+		return zero, WithField(field, err)
+	}
+
+	return result, nil
+}
+
+// Error returns the wrapped error message prefixed with the field name.
+func (e *FieldError) Error() string {
+	return e.Field + ": " + e.Err.Error()
+}
+
+// Unwrap returns the wrapped error.
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// LogValue implements slog.LogValuer. It adds the field name to the attributes of the
+// wrapped error when that error is itself a slog.LogValuer, such as a ConversionError.
+// Nested field names are joined with dots, such as "tx.vout".
+func (e *FieldError) LogValue() slog.Value {
+	var valuer slog.LogValuer
+	if !errors.As(e.Err, &valuer) {
+		return slog.GroupValue(slog.String("field", e.Field), slog.String("error", e.Err.Error()))
+	}
+
+	inner := valuer.LogValue().Resolve()
+	if inner.Kind() != slog.KindGroup {
+		return slog.GroupValue(slog.String("field", e.Field), slog.Any("error", inner))
+	}
+
+	attrs := inner.Group()
+	if len(attrs) > 0 && attrs[0].Key == "field" {
+		return slog.GroupValue(append([]slog.Attr{slog.String("field", e.Field+"."+attrs[0].Value.String())}, attrs[1:]...)...)
+	}
+
+	return slog.GroupValue(append([]slog.Attr{slog.String("field", e.Field)}, attrs...)...)
+}
@@ -0,0 +1,44 @@
+package safeconversion_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	safe "github.com/bsv-blockchain/go-safe-conversion"
+)
+
+// TestLabeled tests labelling conversion errors with a field name.
+func TestLabeled(t *testing.T) {
+	result, err := safe.Labeled("vout", safe.IntToUint32, 3)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(3), result)
+
+	result, err = safe.Labeled("vout", safe.IntToUint32, -1)
+	require.ErrorIs(t, err, safe.ErrValueOutOfRange)
+	assert.Zero(t, result)
+	assert.Equal(t, "vout: value out of range: -1", err.Error())
+
+	var fieldErr *safe.FieldError
+	require.ErrorAs(t, err, &fieldErr)
+	assert.Equal(t, "vout", fieldErr.Field)
+}
+
+// TestWithField tests wrapping errors in a FieldError.
+func TestWithField(t *testing.T) {
+	require.NoError(t, safe.WithField("vout", nil))
+
+	_, convErr := safe.Int64ToInt32(5000000000)
+	err := safe.WithField("tx", safe.WithField("vout", convErr))
+	require.ErrorIs(t, err, safe.ErrValueOutOfRange)
+	assert.Equal(t, "tx: vout: value out of range (int32): 5000000000", err.Error())
+
+	var fieldErr *safe.FieldError
+	require.ErrorAs(t, err, &fieldErr)
+	assert.Equal(t, "tx", fieldErr.Field)
+	require.ErrorAs(t, fieldErr.Err, &fieldErr)
+	assert.Equal(t, "vout", fieldErr.Field)
+	assert.Equal(t, convErr, errors.Unwrap(fieldErr))
+}
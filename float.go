@@ -0,0 +1,127 @@
+package safeconversion
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	// float32Limit is 2^128, the smallest power of two beyond the range of a float32.
+	float32Limit = 0x1p128
+
+	// smallestNormalFloat32 is 2^-126, the smallest positive normal float32.
+	smallestNormalFloat32 = 0x1p-126
+)
+
+// Float64ToFloat32 safely converts a float64 to float32, rounding to the nearest value with
+// ties to even as a conversion does. NaN and infinite values are preserved.
+// Returns an error if a finite value overflows to infinity, or if a non-zero value smaller
+// than the smallest normal float32 loses precision, including values that round to zero.
+func Float64ToFloat32(value float64) (float32, error) {
+	return narrowFloat64("float64", "float32", value, value, RoundHalfEven, false)
+}
+
+// Float64ToFloat32Exact safely converts a float64 to float32 when the value is exactly representable.
+// Returns an error if the value overflows, underflows or would be rounded.
+func Float64ToFloat32Exact(value float64) (float32, error) {
+	return narrowFloat64("float64", "float32", value, value, RoundHalfEven, true)
+}
+
+// Float64ToFloat32Round safely converts a float64 to float32, rounding according to mode.
+// NaN and infinite values are preserved. A finite value beyond the largest float32, however
+// large, only overflows if it rounds beyond it, so RoundDown maps it to ±math.MaxFloat32, as
+// do RoundFloor for positive and RoundCeiling for negative values.
+// Returns an error if the value overflows, underflows as Float64ToFloat32 describes, or if
+// mode is not a valid rounding mode.
+func Float64ToFloat32Round(value float64, mode RoundingMode) (float32, error) {
+	return narrowFloat64("float64", "float32", value, value, mode, false)
+}
+
+// narrowFloat64 converts value to float32 rounded according to mode, failing on overflow and
+// underflow, and on any rounding when exact is set. Failures are reported as converting input
+// from the src type to the dst type, so that value can be a part of a complex input.
+func narrowFloat64(src, dst string, input any, value float64, mode RoundingMode, exact bool) (float32, error) {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return float32(value), nil
+	}
+
+	nearest := float32(value)
+	if !math.IsInf(float64(nearest), 0) && float64(nearest) == value {
+		return nearest, nil
+	}
+
+	result, err := roundFloat32(value, nearest, mode)
+	if err != nil {
+		return 0, err
+	}
+
+	switch {
+	case math.Abs(result) >= float32Limit:
+		return 0, failure(src, dst, input, fmt.Errorf("%s %w: %v", dst, ErrValueOverflow, input))
+	case math.Abs(value) < smallestNormalFloat32:
+		return 0, failure(src, dst, input, fmt.Errorf("%s %w: %v", dst, ErrValueUnderflow, input))
+	case exact:
+		return 0, failure(src, dst, input, fmt.Errorf("%w (%s): %v", ErrInexactValue, dst, input))
+	}
+
+	return float32(result), nil
+}
+
+// roundFloat32 rounds a finite value that is not a float32 to one of the two float32 values
+// around it according to mode, given the nearest float32 as computed by a conversion. The
+// result is returned as a float64 so that it can be ±2^128 when the value overflows.
+func roundFloat32(value float64, nearest float32, mode RoundingMode) (float64, error) {
+	below, above := float32Neighbors(value, nearest)
+	neg := value < 0
+
+	switch mode {
+	case RoundDown:
+		return pick(below, above, neg), nil
+	case RoundUp:
+		return pick(below, above, !neg), nil
+	case RoundFloor:
+		return below, nil
+	case RoundCeiling:
+		return above, nil
+	case RoundHalfUp:
+		// The midpoint of two float32 values is exact in float64.
+		mid := below/2 + above/2
+		if value == mid {
+			return pick(below, above, !neg), nil
+		}
+
+		return pick(below, above, value > mid), nil
+	case RoundHalfEven:
+		if math.IsInf(float64(nearest), 0) {
+			return math.Copysign(float32Limit, value), nil
+		}
+
+		return float64(nearest), nil
+	default:
+		return 0, fmt.Errorf("%w: %s", ErrInvalidRoundingMode, mode)
+	}
+}
+
+// float32Neighbors returns the float32 values directly below and above value, given the nearest
+// float32 to it. Beyond the range of a float32, ±2^128 stands in for the missing neighbor.
+func float32Neighbors(value float64, nearest float32) (float64, float64) {
+	switch {
+	case math.IsInf(float64(nearest), 1) || value > math.MaxFloat32:
+		return math.MaxFloat32, float32Limit
+	case math.IsInf(float64(nearest), -1) || value < -math.MaxFloat32:
+		return -float32Limit, -math.MaxFloat32
+	case float64(nearest) < value:
+		return float64(nearest), float64(math.Nextafter32(nearest, float32(math.Inf(1))))
+	default:
+		return float64(math.Nextafter32(nearest, float32(math.Inf(-1)))), float64(nearest)
+	}
+}
+
+// pick returns above if up is set, and below otherwise.
+func pick(below, above float64, up bool) float64 {
+	if up {
+		return above
+	}
+
+	return below
+}
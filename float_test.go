@@ -0,0 +1,120 @@
+package safeconversion_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	safe "github.com/bsv-blockchain/go-safe-conversion"
+)
+
+// TestFloat64ToFloat32 tests converting float64 values to float32 with round-to-nearest.
+func TestFloat64ToFloat32(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   float64
+		expect  float32
+		wantErr error
+	}{
+		{zeroValueName, 0, 0, nil},
+		{"exact value", 0.5, 0.5, nil},
+		{"rounded value", 0.1, 0.1, nil},
+		{"largest float32", math.MaxFloat32, math.MaxFloat32, nil},
+		{"rounds down to largest float32", math.MaxFloat32 + 0x1p102, math.MaxFloat32, nil},
+		{"exact subnormal", math.SmallestNonzeroFloat32, math.SmallestNonzeroFloat32, nil},
+		{"infinity", math.Inf(-1), float32(math.Inf(-1)), nil},
+		{"overflows to infinity", math.MaxFloat32 + 0x1p103, 0, safe.ErrValueOverflow},
+		{"overflows", -1e300, 0, safe.ErrValueOverflow},
+		{"inexact subnormal", 0x1p-127 + 0x1p-150, 0, safe.ErrValueUnderflow},
+		{"underflows to zero", -math.SmallestNonzeroFloat64, 0, safe.ErrValueUnderflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := safe.Float64ToFloat32(tt.input)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Zero(t, result)
+				return
+			}
+			require.NoError(t, err)
+			assert.InDelta(t, tt.expect, result, 0)
+		})
+	}
+
+	result, err := safe.Float64ToFloat32(math.NaN())
+	require.NoError(t, err)
+	assert.True(t, math.IsNaN(float64(result)))
+}
+
+// TestFloat64ToFloat32Exact tests that only exactly representable values are converted.
+func TestFloat64ToFloat32Exact(t *testing.T) {
+	result, err := safe.Float64ToFloat32Exact(1 << 24)
+	require.NoError(t, err)
+	assert.InDelta(t, float32(1<<24), result, 0)
+
+	_, err = safe.Float64ToFloat32Exact(1<<24 + 1)
+	require.ErrorIs(t, err, safe.ErrInexactValue)
+
+	_, err = safe.Float64ToFloat32Exact(0x1p-150)
+	require.ErrorIs(t, err, safe.ErrValueUnderflow)
+
+	var convErr *safe.ConversionError
+	require.ErrorAs(t, err, &convErr)
+	assert.Equal(t, "float64", convErr.From)
+	assert.Equal(t, "float32", convErr.To)
+	assert.Equal(t, "value underflow", convErr.Reason())
+}
+
+// TestFloat64ToFloat32Round tests converting float64 values to float32 with every rounding mode.
+func TestFloat64ToFloat32Round(t *testing.T) {
+	// 1 + 2^-24 lies halfway between 1 and the next float32, 1 + 2^-23.
+	const (
+		tie  = 1 + 0x1p-24
+		next = 1 + 0x1p-23
+	)
+
+	tests := []struct {
+		name    string
+		input   float64
+		mode    safe.RoundingMode
+		expect  float32
+		wantErr error
+	}{
+		{"down", tie, safe.RoundDown, 1, nil},
+		{"down negative", -tie, safe.RoundDown, -1, nil},
+		{"up", tie, safe.RoundUp, next, nil},
+		{"up negative", -tie, safe.RoundUp, -next, nil},
+		{"floor negative", -tie, safe.RoundFloor, -next, nil},
+		{"ceiling negative", -tie, safe.RoundCeiling, -1, nil},
+		{"half up tie", tie, safe.RoundHalfUp, next, nil},
+		{"half up below tie", tie - 0x1p-30, safe.RoundHalfUp, 1, nil},
+		{"half even tie", tie, safe.RoundHalfEven, 1, nil},
+		{"half even tie to even", next + 0x1p-24, safe.RoundHalfEven, 1 + 0x1p-22, nil},
+		{"down beyond largest float32", math.MaxFloat32 + 0x1p103, safe.RoundDown, math.MaxFloat32, nil},
+		{"up beyond largest float32", math.MaxFloat32 + 0x1p90, safe.RoundUp, 0, safe.ErrValueOverflow},
+		{"down beyond range", 1e39, safe.RoundDown, math.MaxFloat32, nil},
+		{"down negative beyond range", -math.MaxFloat64, safe.RoundDown, -math.MaxFloat32, nil},
+		{"floor beyond range", 0x1p128, safe.RoundFloor, math.MaxFloat32, nil},
+		{"ceiling negative beyond range", -1e39, safe.RoundCeiling, -math.MaxFloat32, nil},
+		{"ceiling beyond range", 1e39, safe.RoundCeiling, 0, safe.ErrValueOverflow},
+		{"half up beyond range", 0x1p128, safe.RoundHalfUp, 0, safe.ErrValueOverflow},
+		{"ceiling to subnormal", 0x1p-150, safe.RoundCeiling, 0, safe.ErrValueUnderflow},
+		{"invalid mode", tie, safe.RoundingMode(99), 0, safe.ErrInvalidRoundingMode},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := safe.Float64ToFloat32Round(tt.input, tt.mode)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Zero(t, result)
+				return
+			}
+			require.NoError(t, err)
+			assert.InDelta(t, tt.expect, result, 0)
+		})
+	}
+}
@@ -0,0 +1,398 @@
+package safeconversion
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/big"
+	"math/bits"
+	"strconv"
+	"strings"
+)
+
+// Uint128 is an unsigned 128-bit integer, for intermediate results and totals that overflow
+// an uint64. All arithmetic is checked and fails with ErrValueOverflow instead of wrapping.
+type Uint128 struct {
+	Hi uint64
+	Lo uint64
+}
+
+// Int128 is a signed 128-bit integer in two's complement form, where Hi carries the sign.
+// All arithmetic is checked and fails with ErrValueOverflow instead of wrapping.
+type Int128 struct {
+	Hi int64
+	Lo uint64
+}
+
+// decimalChunk is the largest power of ten that fits in an uint64, used to format 128-bit values.
+const decimalChunk = 10_000_000_000_000_000_000
+
+// MulDiv computes a*b/c through a 128-bit intermediate product, truncating the result.
+// Returns an error if c is zero or the result does not fit in an uint64.
+func MulDiv(a, b, c uint64) (uint64, error) {
+	return mulDivMagnitude(a, b, c, false, RoundDown)
+}
+
+// NewUint128 converts any integer to an Uint128.
+// Returns an error if the value is negative.
+func NewUint128[T Integer](value T) (Uint128, error) {
+	if value < 0 {
+		return Uint128{}, failure(typeName[T](), "uint128", value, fmt.Errorf("%w to uint128: %d", ErrNegativeValueCannotBeConverted, value))
+	}
+
+	return Uint128{Lo: uint64(value)}, nil
+}
+
+// Uint128FromBig converts a *big.Int to an Uint128.
+// Returns an error if the value is nil, negative or wider than 128 bits.
+func Uint128FromBig(value *big.Int) (Uint128, error) {
+	if value == nil {
+		return Uint128{}, fmt.Errorf("%w for uint128: nil *big.Int", ErrUnsupportedType)
+	}
+
+	if value.Sign() < 0 {
+		return Uint128{}, failure("*big.Int", "uint128", bigCopy(value), fmt.Errorf("%w to uint128: %s", ErrNegativeValueCannotBeConverted, value))
+	}
+
+	if value.BitLen() > 128 {
+		return Uint128{}, failure("*big.Int", "uint128", bigCopy(value), fmt.Errorf("uint128 %w: %s", ErrValueOverflow, value))
+	}
+
+	var buf [16]byte
+	value.FillBytes(buf[:])
+
+	return Uint128{Hi: binary.BigEndian.Uint64(buf[:8]), Lo: binary.BigEndian.Uint64(buf[8:])}, nil
+}
+
+// Uint128To converts an Uint128 to any integer type.
+// Returns an error if the value exceeds the range of T.
+func Uint128To[T Integer](u Uint128) (T, error) {
+	if u.Hi != 0 || u.Lo > toUint64(maxOf[T]()) {
+		return 0, failure("uint128", typeName[T](), u, fmt.Errorf("%s %w: %s", typeName[T](), ErrValueOverflow, u))
+	}
+
+	return T(u.Lo), nil
+}
+
+// Uint64 converts the value to an uint64.
+// Returns an error if the value exceeds the maximum value of an uint64.
+func (u Uint128) Uint64() (uint64, error) {
+	return Uint128To[uint64](u)
+}
+
+// Big returns the value as a *big.Int.
+func (u Uint128) Big() *big.Int {
+	var buf [16]byte
+	binary.BigEndian.PutUint64(buf[:8], u.Hi)
+	binary.BigEndian.PutUint64(buf[8:], u.Lo)
+
+	return new(big.Int).SetBytes(buf[:])
+}
+
+// Int128 converts the value to an Int128.
+// Returns an error if the value exceeds the maximum value of an Int128.
+func (u Uint128) Int128() (Int128, error) {
+	if u.Hi > math.MaxInt64 {
+		return Int128{}, failure("uint128", "int128", u, fmt.Errorf("int128 %w: %s", ErrValueOverflow, u))
+	}
+
+	return Int128{Hi: int64(u.Hi), Lo: u.Lo}, nil
+}
+
+// IsZero reports whether the value is zero.
+func (u Uint128) IsZero() bool {
+	return u.Hi == 0 && u.Lo == 0
+}
+
+// Cmp compares u and v and returns -1 if u < v, 0 if u == v, and +1 if u > v.
+func (u Uint128) Cmp(v Uint128) int {
+	switch {
+	case u.Hi < v.Hi || (u.Hi == v.Hi && u.Lo < v.Lo):
+		return -1
+	case u.Hi > v.Hi || u.Lo > v.Lo:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Add returns u + v.
+// Returns an error if the result overflows 128 bits.
+func (u Uint128) Add(v Uint128) (Uint128, error) {
+	lo, carry := bits.Add64(u.Lo, v.Lo, 0)
+	hi, carry := bits.Add64(u.Hi, v.Hi, carry)
+	if carry != 0 {
+		return Uint128{}, fmt.Errorf("uint128 %w: %s + %s", ErrValueOverflow, u, v)
+	}
+
+	return Uint128{Hi: hi, Lo: lo}, nil
+}
+
+// Sub returns u - v.
+// Returns an error if v is greater than u.
+func (u Uint128) Sub(v Uint128) (Uint128, error) {
+	lo, borrow := bits.Sub64(u.Lo, v.Lo, 0)
+	hi, borrow := bits.Sub64(u.Hi, v.Hi, borrow)
+	if borrow != 0 {
+		return Uint128{}, fmt.Errorf("uint128 %w: %s - %s", ErrValueOverflow, u, v)
+	}
+
+	return Uint128{Hi: hi, Lo: lo}, nil
+}
+
+// Mul returns u * v.
+// Returns an error if the result overflows 128 bits.
+func (u Uint128) Mul(v Uint128) (Uint128, error) {
+	if u.Hi != 0 && v.Hi != 0 {
+		return Uint128{}, fmt.Errorf("uint128 %w: %s * %s", ErrValueOverflow, u, v)
+	}
+
+	hi, lo := bits.Mul64(u.Lo, v.Lo)
+	crossHi1, cross1 := bits.Mul64(u.Hi, v.Lo)
+	crossHi2, cross2 := bits.Mul64(u.Lo, v.Hi)
+
+	hi, carry1 := bits.Add64(hi, cross1, 0)
+	hi, carry2 := bits.Add64(hi, cross2, 0)
+	if crossHi1 != 0 || crossHi2 != 0 || carry1 != 0 || carry2 != 0 {
+		return Uint128{}, fmt.Errorf("uint128 %w: %s * %s", ErrValueOverflow, u, v)
+	}
+
+	return Uint128{Hi: hi, Lo: lo}, nil
+}
+
+// Div64 returns the quotient and remainder of u / d.
+// Returns ErrDivisionByZero if d is zero.
+func (u Uint128) Div64(d uint64) (Uint128, uint64, error) {
+	if d == 0 {
+		return Uint128{}, 0, fmt.Errorf("uint128 %w: %s / 0", ErrDivisionByZero, u)
+	}
+
+	hi, r := u.Hi/d, u.Hi%d
+	lo, r := bits.Div64(r, u.Lo, d)
+
+	return Uint128{Hi: hi, Lo: lo}, r, nil
+}
+
+// String returns the value in base 10.
+func (u Uint128) String() string {
+	if u.Hi == 0 {
+		return strconv.FormatUint(u.Lo, 10)
+	}
+
+	q, r, _ := u.Div64(decimalChunk)
+	low := strconv.FormatUint(r, 10)
+
+	return q.String() + strings.Repeat("0", 19-len(low)) + low
+}
+
+// NewInt128 converts any integer to an Int128. The conversion is always safe.
+func NewInt128[T Integer](value T) Int128 {
+	if value < 0 {
+		v := int64(value)
+		return Int128{Hi: -1, Lo: uint64(v)}
+	}
+
+	return Int128{Lo: uint64(value)}
+}
+
+// Int128FromBig converts a *big.Int to an Int128.
+// Returns an error if the value is nil or outside the Int128 range.
+func Int128FromBig(value *big.Int) (Int128, error) {
+	if value == nil {
+		return Int128{}, fmt.Errorf("%w for int128: nil *big.Int", ErrUnsupportedType)
+	}
+
+	if value.BitLen() > 128 {
+		return Int128{}, failure("*big.Int", "int128", bigCopy(value), fmt.Errorf("int128 %w: %s", ErrValueOverflow, value))
+	}
+
+	magnitude, _ := Uint128FromBig(new(big.Int).Abs(value))
+
+	result, err := int128FromMagnitude(value.Sign() < 0, magnitude)
+	if err != nil {
+		return Int128{}, failure("*big.Int", "int128", bigCopy(value), err)
+	}
+
+	return result, nil
+}
+
+// Int128To converts an Int128 to any integer type.
+// Returns an error if the value is negative and T is unsigned, or if it exceeds the range of T.
+func Int128To[T Integer](i Int128) (T, error) {
+	neg, magnitude := i.magnitude()
+	if neg && !isSigned[T]() {
+		return 0, failure("int128", typeName[T](), i, fmt.Errorf("%w to %s: %s", ErrNegativeValueCannotBeConverted, typeName[T](), i))
+	}
+
+	// The most negative value of a signed type has a magnitude one larger than its maximum.
+	limit := toUint64(maxOf[T]())
+	if neg {
+		limit++
+	}
+
+	if magnitude.Hi != 0 || magnitude.Lo > limit {
+		return 0, failure("int128", typeName[T](), i, fmt.Errorf("%s %w: %s", typeName[T](), ErrValueOverflow, i))
+	}
+
+	// The low 64 bits hold the two's complement value, which truncates correctly once range checked.
+	return T(i.Lo), nil
+}
+
+// Int64 converts the value to an int64.
+// Returns an error if the value is outside the int64 range.
+func (i Int128) Int64() (int64, error) {
+	return Int128To[int64](i)
+}
+
+// Big returns the value as a *big.Int.
+func (i Int128) Big() *big.Int {
+	neg, magnitude := i.magnitude()
+
+	value := magnitude.Big()
+	if neg {
+		value.Neg(value)
+	}
+
+	return value
+}
+
+// Uint128 converts the value to an Uint128.
+// Returns an error if the value is negative.
+func (i Int128) Uint128() (Uint128, error) {
+	if i.Hi < 0 {
+		return Uint128{}, failure("int128", "uint128", i, fmt.Errorf("%w to uint128: %s", ErrNegativeValueCannotBeConverted, i))
+	}
+
+	return Uint128{Hi: uint64(i.Hi), Lo: i.Lo}, nil
+}
+
+// Sign returns -1 if the value is negative, 0 if it is zero, and +1 if it is positive.
+func (i Int128) Sign() int {
+	switch {
+	case i.Hi < 0:
+		return -1
+	case i.Hi == 0 && i.Lo == 0:
+		return 0
+	default:
+		return 1
+	}
+}
+
+// Cmp compares i and j and returns -1 if i < j, 0 if i == j, and +1 if i > j.
+func (i Int128) Cmp(j Int128) int {
+	switch {
+	case i.Hi < j.Hi || (i.Hi == j.Hi && i.Lo < j.Lo):
+		return -1
+	case i.Hi > j.Hi || i.Lo > j.Lo:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Neg returns -i.
+// Returns an error if i is the most negative Int128, whose negation overflows.
+func (i Int128) Neg() (Int128, error) {
+	neg, magnitude := i.magnitude()
+	return int128FromMagnitude(!neg && !magnitude.IsZero(), magnitude)
+}
+
+// Add returns i + j.
+// Returns an error if the result overflows.
+func (i Int128) Add(j Int128) (Int128, error) {
+	lo, carry := bits.Add64(i.Lo, j.Lo, 0)
+	hi, _ := bits.Add64(uint64(i.Hi), uint64(j.Hi), carry)
+	result := Int128{Hi: int64(hi), Lo: lo}
+
+	// Overflow occurred if both operands share a sign that differs from the result's sign.
+	if (i.Hi < 0) == (j.Hi < 0) && (result.Hi < 0) != (i.Hi < 0) {
+		return Int128{}, fmt.Errorf("int128 %w: %s + %s", ErrValueOverflow, i, j)
+	}
+
+	return result, nil
+}
+
+// Sub returns i - j.
+// Returns an error if the result overflows.
+func (i Int128) Sub(j Int128) (Int128, error) {
+	lo, borrow := bits.Sub64(i.Lo, j.Lo, 0)
+	hi, _ := bits.Sub64(uint64(i.Hi), uint64(j.Hi), borrow)
+	result := Int128{Hi: int64(hi), Lo: lo}
+
+	// Overflow occurred if the operands have different signs and the result's sign differs from i.
+	if (i.Hi < 0) != (j.Hi < 0) && (result.Hi < 0) != (i.Hi < 0) {
+		return Int128{}, fmt.Errorf("int128 %w: %s - %s", ErrValueOverflow, i, j)
+	}
+
+	return result, nil
+}
+
+// Mul returns i * j.
+// Returns an error if the result overflows.
+func (i Int128) Mul(j Int128) (Int128, error) {
+	iNeg, iMagnitude := i.magnitude()
+	jNeg, jMagnitude := j.magnitude()
+
+	magnitude, err := iMagnitude.Mul(jMagnitude)
+	if err != nil {
+		return Int128{}, fmt.Errorf("int128 %w: %s * %s", ErrValueOverflow, i, j)
+	}
+
+	result, err := int128FromMagnitude(iNeg != jNeg && !magnitude.IsZero(), magnitude)
+	if err != nil {
+		return Int128{}, fmt.Errorf("int128 %w: %s * %s", ErrValueOverflow, i, j)
+	}
+
+	return result, nil
+}
+
+// String returns the value in base 10.
+func (i Int128) String() string {
+	neg, magnitude := i.magnitude()
+	if neg {
+		return "-" + magnitude.String()
+	}
+
+	return magnitude.String()
+}
+
+// magnitude returns the sign and absolute value of i.
+func (i Int128) magnitude() (bool, Uint128) {
+	if i.Hi >= 0 {
+		return false, Uint128{Hi: uint64(i.Hi), Lo: i.Lo}
+	}
+
+	lo, borrow := bits.Sub64(0, i.Lo, 0)
+	hi, _ := bits.Sub64(0, uint64(i.Hi), borrow)
+
+	return true, Uint128{Hi: hi, Lo: lo}
+}
+
+// bigCopy returns a copy of value, so that an error does not share a *big.Int the caller may modify.
+func bigCopy(value *big.Int) *big.Int {
+	return new(big.Int).Set(value)
+}
+
+// int128FromMagnitude combines a sign and absolute value into an Int128.
+// Returns an error if the result is outside the Int128 range.
+func int128FromMagnitude(neg bool, magnitude Uint128) (Int128, error) {
+	// The Int128 range is [-2^127, 2^127-1].
+	limit := Uint128{Hi: 1 << 63}
+	if cmp := magnitude.Cmp(limit); cmp > 0 || (cmp == 0 && !neg) {
+		sign := ""
+		if neg {
+			sign = "-"
+		}
+
+		return Int128{}, fmt.Errorf("int128 %w: %s%s", ErrValueOverflow, sign, magnitude)
+	}
+
+	if !neg {
+		return Int128{Hi: int64(magnitude.Hi), Lo: magnitude.Lo}, nil
+	}
+
+	lo, borrow := bits.Sub64(0, magnitude.Lo, 0)
+	hi, _ := bits.Sub64(0, magnitude.Hi, borrow)
+
+	return Int128{Hi: int64(hi), Lo: lo}, nil
+}
@@ -0,0 +1,380 @@
+package safeconversion_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	safe "github.com/bsv-blockchain/go-safe-conversion"
+)
+
+// maxUint128 returns the largest Uint128.
+func maxUint128() safe.Uint128 { return safe.Uint128{Hi: math.MaxUint64, Lo: math.MaxUint64} }
+
+// maxInt128 returns the largest Int128.
+func maxInt128() safe.Int128 { return safe.Int128{Hi: math.MaxInt64, Lo: math.MaxUint64} }
+
+// minInt128 returns the smallest Int128.
+func minInt128() safe.Int128 { return safe.Int128{Hi: math.MinInt64} }
+
+// bigFromString parses a base 10 *big.Int for test tables.
+func bigFromString(t *testing.T, s string) *big.Int {
+	t.Helper()
+	v, ok := new(big.Int).SetString(s, 10)
+	require.True(t, ok)
+	return v
+}
+
+// TestMulDiv tests computing a*b/c through 128 bits.
+func TestMulDiv(t *testing.T) {
+	tests := []struct {
+		name    string
+		a, b, c uint64
+		expect  uint64
+		wantErr error
+	}{
+		{zeroValueName, 0, 0, 1, 0, nil},
+		{"fee calculation", 226, 500, 1000, 113, nil},
+		{"intermediate exceeds uint64", math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, nil},
+		{"truncates", 10, 1, 3, 3, nil},
+		{"result overflows", math.MaxUint64, 2, 1, 0, safe.ErrValueOverflow},
+		{"division by zero", 1, 1, 0, 0, safe.ErrDivisionByZero},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := safe.MulDiv(tt.a, tt.b, tt.c)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expect, result)
+		})
+	}
+}
+
+// TestNewUint128 tests converting native integers to Uint128.
+func TestNewUint128(t *testing.T) {
+	u, err := safe.NewUint128(uint64(math.MaxUint64))
+	require.NoError(t, err)
+	assert.Equal(t, safe.Uint128{Lo: math.MaxUint64}, u)
+
+	u, err = safe.NewUint128(int8(5))
+	require.NoError(t, err)
+	assert.Equal(t, safe.Uint128{Lo: 5}, u)
+
+	_, err = safe.NewUint128(-1)
+	require.ErrorIs(t, err, safe.ErrNegativeValueCannotBeConverted)
+}
+
+// TestUint128To tests narrowing Uint128 to native integers.
+func TestUint128To(t *testing.T) {
+	v64, err := safe.Uint128To[uint64](safe.Uint128{Lo: math.MaxUint64})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(math.MaxUint64), v64)
+
+	_, err = safe.Uint128To[uint64](safe.Uint128{Hi: 1})
+	require.ErrorIs(t, err, safe.ErrValueOverflow)
+
+	v32, err := safe.Uint128To[int32](safe.Uint128{Lo: math.MaxInt32})
+	require.NoError(t, err)
+	assert.Equal(t, int32(math.MaxInt32), v32)
+
+	_, err = safe.Uint128To[int32](safe.Uint128{Lo: math.MaxInt32 + 1})
+	require.ErrorIs(t, err, safe.ErrValueOverflow)
+
+	v, err := safe.Uint128{Lo: 42}.Uint64()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(42), v)
+}
+
+// TestUint128Big tests converting between Uint128 and *big.Int.
+func TestUint128Big(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   *big.Int
+		expect  safe.Uint128
+		wantErr error
+	}{
+		{zeroValueName, big.NewInt(0), safe.Uint128{}, nil},
+		{maxUint64Name, new(big.Int).SetUint64(math.MaxUint64), safe.Uint128{Lo: math.MaxUint64}, nil},
+		{"max uint128", new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1)), maxUint128(), nil},
+		{valueTooLargeName, new(big.Int).Lsh(big.NewInt(1), 128), safe.Uint128{}, safe.ErrValueOverflow},
+		{negativeValueName, big.NewInt(-1), safe.Uint128{}, safe.ErrNegativeValueCannotBeConverted},
+		{"nil value", nil, safe.Uint128{}, safe.ErrUnsupportedType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := safe.Uint128FromBig(tt.input)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expect, result)
+			assert.Equal(t, 0, tt.input.Cmp(result.Big()))
+		})
+	}
+}
+
+// TestUint128Arithmetic tests checked Uint128 arithmetic.
+func TestUint128Arithmetic(t *testing.T) {
+	sum, err := safe.Uint128{Lo: math.MaxUint64}.Add(safe.Uint128{Lo: 1})
+	require.NoError(t, err)
+	assert.Equal(t, safe.Uint128{Hi: 1}, sum)
+
+	_, err = maxUint128().Add(safe.Uint128{Lo: 1})
+	require.ErrorIs(t, err, safe.ErrValueOverflow)
+
+	diff, err := safe.Uint128{Hi: 1}.Sub(safe.Uint128{Lo: 1})
+	require.NoError(t, err)
+	assert.Equal(t, safe.Uint128{Lo: math.MaxUint64}, diff)
+
+	_, err = safe.Uint128{}.Sub(safe.Uint128{Lo: 1})
+	require.ErrorIs(t, err, safe.ErrValueOverflow)
+
+	product, err := safe.Uint128{Lo: math.MaxUint64}.Mul(safe.Uint128{Lo: math.MaxUint64})
+	require.NoError(t, err)
+	assert.Equal(t, safe.Uint128{Hi: math.MaxUint64 - 1, Lo: 1}, product)
+
+	_, err = safe.Uint128{Hi: 1}.Mul(safe.Uint128{Hi: 1})
+	require.ErrorIs(t, err, safe.ErrValueOverflow)
+
+	_, err = safe.Uint128{Hi: math.MaxUint64}.Mul(safe.Uint128{Lo: 2})
+	require.ErrorIs(t, err, safe.ErrValueOverflow)
+
+	q, r, err := safe.Uint128{Hi: 1, Lo: 1}.Div64(2)
+	require.NoError(t, err)
+	assert.Equal(t, safe.Uint128{Lo: 1 << 63}, q)
+	assert.Equal(t, uint64(1), r)
+
+	_, _, err = maxUint128().Div64(0)
+	require.ErrorIs(t, err, safe.ErrDivisionByZero)
+
+	assert.Equal(t, -1, safe.Uint128{Lo: 1}.Cmp(safe.Uint128{Hi: 1}))
+	assert.Equal(t, 1, safe.Uint128{Hi: 1}.Cmp(safe.Uint128{Lo: math.MaxUint64}))
+	assert.Equal(t, 0, maxUint128().Cmp(maxUint128()))
+	assert.True(t, safe.Uint128{}.IsZero())
+}
+
+// TestUint128String tests formatting Uint128 values.
+func TestUint128String(t *testing.T) {
+	assert.Equal(t, "0", safe.Uint128{}.String())
+	assert.Equal(t, "18446744073709551616", safe.Uint128{Hi: 1}.String())
+	assert.Equal(t, "340282366920938463463374607431768211455", maxUint128().String())
+	assert.Equal(t, "10000000000000000000000000000000000000", safe.Uint128{Hi: 542101086242752217, Lo: 68739955140067328}.String())
+}
+
+// TestNewInt128 tests converting native integers to Int128.
+func TestNewInt128(t *testing.T) {
+	assert.Equal(t, "-9223372036854775808", safe.NewInt128(int64(math.MinInt64)).String())
+	assert.Equal(t, "18446744073709551615", safe.NewInt128(uint64(math.MaxUint64)).String())
+	assert.Equal(t, "-1", safe.NewInt128(int8(-1)).String())
+	assert.Equal(t, safe.Int128{}, safe.NewInt128(0))
+}
+
+// TestInt128To tests narrowing Int128 to native integers.
+func TestInt128To(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   safe.Int128
+		expect  int64
+		wantErr error
+	}{
+		{zeroValueName, safe.Int128{}, 0, nil},
+		{maxInt64Name, safe.NewInt128(int64(math.MaxInt64)), math.MaxInt64, nil},
+		{minInt64Name, safe.NewInt128(int64(math.MinInt64)), math.MinInt64, nil},
+		{valueTooLargeName, safe.NewInt128(uint64(math.MaxInt64) + 1), 0, safe.ErrValueOverflow},
+		{valueTooSmallName, safe.Int128{Hi: -1, Lo: 1<<63 - 1}, 0, safe.ErrValueOverflow},
+		{"max int128", maxInt128(), 0, safe.ErrValueOverflow},
+		{"min int128", minInt128(), 0, safe.ErrValueOverflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.input.Int64()
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expect, result)
+		})
+	}
+
+	v8, err := safe.Int128To[int8](safe.NewInt128(-128))
+	require.NoError(t, err)
+	assert.Equal(t, int8(-128), v8)
+
+	_, err = safe.Int128To[int8](safe.NewInt128(-129))
+	require.ErrorIs(t, err, safe.ErrValueOverflow)
+
+	_, err = safe.Int128To[uint32](safe.NewInt128(-1))
+	require.ErrorIs(t, err, safe.ErrNegativeValueCannotBeConverted)
+
+	v64, err := safe.Int128To[uint64](safe.NewInt128(uint64(math.MaxUint64)))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(math.MaxUint64), v64)
+}
+
+// TestInt128Big tests converting between Int128 and *big.Int.
+func TestInt128Big(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		expect  safe.Int128
+		wantErr error
+	}{
+		{zeroValueName, "0", safe.Int128{}, nil},
+		{negativeValueName, "-1", safe.Int128{Hi: -1, Lo: math.MaxUint64}, nil},
+		{"max int128", "170141183460469231731687303715884105727", maxInt128(), nil},
+		{"min int128", "-170141183460469231731687303715884105728", minInt128(), nil},
+		{valueTooLargeName, "170141183460469231731687303715884105728", safe.Int128{}, safe.ErrValueOverflow},
+		{valueTooSmallName, "-170141183460469231731687303715884105729", safe.Int128{}, safe.ErrValueOverflow},
+		{valueMuchTooLargeName, "1000000000000000000000000000000000000000000", safe.Int128{}, safe.ErrValueOverflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := bigFromString(t, tt.input)
+			result, err := safe.Int128FromBig(input)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expect, result)
+			assert.Equal(t, tt.input, result.Big().String())
+			assert.Equal(t, tt.input, result.String())
+		})
+	}
+
+	_, err := safe.Int128FromBig(nil)
+	require.ErrorIs(t, err, safe.ErrUnsupportedType)
+}
+
+// TestInt128Arithmetic tests checked Int128 arithmetic.
+func TestInt128Arithmetic(t *testing.T) {
+	one := safe.NewInt128(1)
+
+	_, err := maxInt128().Add(one)
+	require.ErrorIs(t, err, safe.ErrValueOverflow)
+
+	_, err = minInt128().Sub(one)
+	require.ErrorIs(t, err, safe.ErrValueOverflow)
+
+	_, err = minInt128().Neg()
+	require.ErrorIs(t, err, safe.ErrValueOverflow)
+
+	neg, err := maxInt128().Neg()
+	require.NoError(t, err)
+	assert.Equal(t, "-170141183460469231731687303715884105727", neg.String())
+
+	zero, err := safe.Int128{}.Neg()
+	require.NoError(t, err)
+	assert.Equal(t, safe.Int128{}, zero)
+
+	product, err := safe.NewInt128(int64(math.MinInt64)).Mul(safe.NewInt128(int64(math.MaxInt64)))
+	require.NoError(t, err)
+	assert.Equal(t, "-85070591730234615856620279821087277056", product.String())
+
+	almostMin, err := minInt128().Add(one)
+	require.NoError(t, err)
+	product, err = safe.NewInt128(-1).Mul(almostMin)
+	require.NoError(t, err)
+	assert.Equal(t, maxInt128(), product)
+
+	_, err = safe.NewInt128(-1).Mul(minInt128())
+	require.ErrorIs(t, err, safe.ErrValueOverflow)
+
+	_, err = maxInt128().Mul(safe.NewInt128(2))
+	require.ErrorIs(t, err, safe.ErrValueOverflow)
+
+	assert.Equal(t, -1, minInt128().Cmp(maxInt128()))
+	assert.Equal(t, 1, one.Cmp(safe.NewInt128(-1)))
+	assert.Equal(t, 0, one.Cmp(one))
+	assert.Equal(t, -1, minInt128().Sign())
+	assert.Equal(t, 0, safe.Int128{}.Sign())
+	assert.Equal(t, 1, one.Sign())
+
+	u, err := maxInt128().Uint128()
+	require.NoError(t, err)
+	back, err := u.Int128()
+	require.NoError(t, err)
+	assert.Equal(t, maxInt128(), back)
+
+	_, err = safe.NewInt128(-1).Uint128()
+	require.ErrorIs(t, err, safe.ErrNegativeValueCannotBeConverted)
+
+	_, err = maxUint128().Int128()
+	require.ErrorIs(t, err, safe.ErrValueOverflow)
+}
+
+// FuzzInt128Arithmetic validates Int128 arithmetic against math/big.
+func FuzzInt128Arithmetic(f *testing.F) {
+	f.Add(int64(0), uint64(0), int64(0), uint64(0))
+	f.Add(int64(math.MaxInt64), uint64(math.MaxUint64), int64(0), uint64(1))
+	f.Add(int64(math.MinInt64), uint64(0), int64(-1), uint64(math.MaxUint64))
+	f.Fuzz(func(t *testing.T, aHi int64, aLo uint64, bHi int64, bLo uint64) {
+		a, b := safe.Int128{Hi: aHi, Lo: aLo}, safe.Int128{Hi: bHi, Lo: bLo}
+		ops := []struct {
+			name   string
+			fn     func(safe.Int128) (safe.Int128, error)
+			expect *big.Int
+		}{
+			{"add", a.Add, new(big.Int).Add(a.Big(), b.Big())},
+			{"sub", a.Sub, new(big.Int).Sub(a.Big(), b.Big())},
+			{"mul", a.Mul, new(big.Int).Mul(a.Big(), b.Big())},
+		}
+
+		for _, op := range ops {
+			result, err := op.fn(b)
+			if _, rangeErr := safe.Int128FromBig(op.expect); rangeErr != nil {
+				require.ErrorIs(t, err, safe.ErrValueOverflow, op.name)
+				continue
+			}
+			require.NoError(t, err, op.name)
+			assert.Equal(t, op.expect.String(), result.String(), op.name)
+		}
+		assert.Equal(t, a.Big().Cmp(b.Big()), a.Cmp(b))
+	})
+}
+
+// FuzzUint128Arithmetic validates Uint128 arithmetic against math/big.
+func FuzzUint128Arithmetic(f *testing.F) {
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(0))
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(0), uint64(1))
+	f.Add(uint64(0), uint64(math.MaxUint64), uint64(0), uint64(math.MaxUint64))
+	f.Fuzz(func(t *testing.T, aHi, aLo, bHi, bLo uint64) {
+		a, b := safe.Uint128{Hi: aHi, Lo: aLo}, safe.Uint128{Hi: bHi, Lo: bLo}
+		ops := []struct {
+			name   string
+			fn     func(safe.Uint128) (safe.Uint128, error)
+			expect *big.Int
+		}{
+			{"add", a.Add, new(big.Int).Add(a.Big(), b.Big())},
+			{"sub", a.Sub, new(big.Int).Sub(a.Big(), b.Big())},
+			{"mul", a.Mul, new(big.Int).Mul(a.Big(), b.Big())},
+		}
+
+		for _, op := range ops {
+			result, err := op.fn(b)
+			if _, rangeErr := safe.Uint128FromBig(op.expect); rangeErr != nil {
+				require.ErrorIs(t, err, safe.ErrValueOverflow, op.name)
+				continue
+			}
+			require.NoError(t, err, op.name)
+			assert.Equal(t, op.expect.String(), result.String(), op.name)
+		}
+		assert.Equal(t, a.Big().Cmp(b.Big()), a.Cmp(b))
+	})
+}
@@ -0,0 +1,39 @@
+package safeconversion
+
+import (
+	"fmt"
+	"math/bits"
+	"unsafe"
+)
+
+// LenToUint32 safely converts the length of a slice to uint32.
+// Returns an error if the length exceeds the maximum value of an uint32.
+func LenToUint32[S ~[]E, E any](s S) (uint32, error) {
+	return IntToUint32(len(s))
+}
+
+// LenToUint16 safely converts the length of a slice to uint16.
+// Returns an error if the length exceeds the maximum value of an uint16.
+func LenToUint16[S ~[]E, E any](s S) (uint16, error) {
+	return IntToUint16(len(s))
+}
+
+// MakeSliceChecked allocates a slice of n elements of type T.
+// The length is converted with Uint64ToInt and the size of the backing array is checked
+// against maxBytes before allocating, so lengths read from untrusted input cannot trigger
+// an oversized allocation.
+// Returns an error if n exceeds the range of an int or the allocation would exceed maxBytes.
+func MakeSliceChecked[T any](n uint64, maxBytes int) ([]T, error) {
+	length, err := Uint64ToInt(n)
+	if err != nil {
+		return nil, err
+	}
+
+	var zero T
+	hi, size := bits.Mul64(n, uint64(unsafe.Sizeof(zero)))
+	if hi != 0 || maxBytes < 0 || size > uint64(maxBytes) {
+		return nil, fmt.Errorf("%w (%T slice of %d): max %d bytes", ErrValueExceedsLimit, zero, n, maxBytes)
+	}
+
+	return make([]T, length), nil
+}
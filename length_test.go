@@ -0,0 +1,89 @@
+package safeconversion_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	safe "github.com/bsv-blockchain/go-safe-conversion"
+)
+
+// TestLenToUint32 tests converting slice lengths to uint32.
+func TestLenToUint32(t *testing.T) {
+	result, err := safe.LenToUint32([]byte(nil))
+	require.NoError(t, err)
+	assert.Equal(t, uint32(0), result)
+
+	result, err = safe.LenToUint32(make([]uint64, 100))
+	require.NoError(t, err)
+	assert.Equal(t, uint32(100), result)
+}
+
+// TestLenToUint16 tests converting slice lengths to uint16.
+func TestLenToUint16(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   []struct{}
+		expect  uint16
+		wantErr error
+	}{
+		{zeroValueName, nil, 0, nil},
+		{positiveValueName, make([]struct{}, 100), 100, nil},
+		{maxUint16Name, make([]struct{}, math.MaxUint16), math.MaxUint16, nil},
+		{valueTooLargeName, make([]struct{}, math.MaxUint16+1), 0, safe.ErrValueExceedsLimit},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := safe.LenToUint16(tt.input)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expect, result)
+		})
+	}
+}
+
+// TestMakeSliceChecked tests allocating slices with a memory ceiling.
+func TestMakeSliceChecked(t *testing.T) {
+	tests := []struct {
+		name     string
+		n        uint64
+		maxBytes int
+		wantErr  error
+	}{
+		{zeroValueName, 0, 0, nil},
+		{positiveValueName, 100, 1024, nil},
+		{"at limit", 128, 1024, nil},
+		{"above limit", 129, 1024, safe.ErrValueExceedsLimit},
+		{"negative limit", 0, -1, safe.ErrValueExceedsLimit},
+		{"byte size overflows", math.MaxUint64 / 4, math.MaxInt, safe.ErrValueExceedsLimit},
+		{"length exceeds int", math.MaxUint64, math.MaxInt, safe.ErrValueExceedsLimit},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := safe.MakeSliceChecked[uint64](tt.n, tt.maxBytes)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, result)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.n, uint64(len(result)))
+		})
+	}
+}
+
+// TestMakeSliceCheckedZeroSize tests that zero-sized elements never exceed the memory ceiling.
+func TestMakeSliceCheckedZeroSize(t *testing.T) {
+	result, err := safe.MakeSliceChecked[struct{}](1<<40, 0)
+	require.NoError(t, err)
+	assert.Len(t, result, 1<<40)
+}
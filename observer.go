@@ -0,0 +1,113 @@
+package safeconversion
+
+import (
+	"expvar"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync/atomic"
+)
+
+// packagePath is the import path of this package, used to skip its own frames when
+// attributing a failure to a call site.
+const packagePath = "github.com/bsv-blockchain/go-safe-conversion."
+
+// Observer is notified of every failed conversion made by the package-level conversion
+// functions, including those made through a Policy or a Batch. The src and dst arguments
+// name the source and destination types, such as "uint64" and "int", and err is a *ConversionError
+// holding the same value. OnFailure may be called concurrently, so implementations must be safe
+// for concurrent use.
+type Observer interface {
+	OnFailure(src, dst string, value any, err error)
+}
+
+// observer holds the installed Observer, or nil when none is installed.
+//
+//nolint:gochecknoglobals // the observer is process-wide so that every call site is covered
+var observer atomic.Pointer[Observer]
+
+// SetObserver installs o as the process-wide Observer, replacing any previous one.
+// Passing nil removes the observer. Conversions that succeed never consult the observer,
+// and failed conversions only pay for an atomic load when none is installed.
+func SetObserver(o Observer) {
+	if o == nil {
+		observer.Store(nil)
+		return
+	}
+
+	observer.Store(&o)
+}
+
+// ExpvarObserver is an Observer that counts failed conversions in an expvar.Map, keyed
+// by conversion (such as "uint64_to_int") under "conversions" and by the file and line of
+// the calling code under "call_sites".
+type ExpvarObserver struct {
+	conversions *expvar.Map
+	callSites   *expvar.Map
+}
+
+// NewExpvarObserver returns an ExpvarObserver that stores its counters in root, which is
+// usually published with expvar.NewMap:
+//
+//	SetObserver(NewExpvarObserver(expvar.NewMap("safeconversion")))
+func NewExpvarObserver(root *expvar.Map) *ExpvarObserver {
+	o := &ExpvarObserver{
+		conversions: new(expvar.Map).Init(),
+		callSites:   new(expvar.Map).Init(),
+	}
+
+	root.Set("conversions", o.conversions)
+	root.Set("call_sites", o.callSites)
+
+	return o
+}
+
+// OnFailure increments the counters for the conversion and its call site.
+func (o *ExpvarObserver) OnFailure(src, dst string, _ any, _ error) {
+	o.conversions.Add(src+"_to_"+dst, 1)
+
+	if site := callSite(); site != "" {
+		o.callSites.Add(site, 1)
+	}
+}
+
+// Conversions returns the counters keyed by conversion.
+func (o *ExpvarObserver) Conversions() *expvar.Map {
+	return o.conversions
+}
+
+// CallSites returns the counters keyed by call site.
+func (o *ExpvarObserver) CallSites() *expvar.Map {
+	return o.callSites
+}
+
+// failure wraps err in a ConversionError and notifies the installed Observer, if any.
+func failure(src, dst string, value any, err error) error {
+	return notify(newConversionError(src, dst, value, err))
+}
+
+// notify reports convErr to the installed Observer, if any, and returns it.
+func notify(convErr *ConversionError) error {
+	if o := observer.Load(); o != nil {
+		(*o).OnFailure(convErr.From, convErr.To, convErr.Value, convErr)
+	}
+
+	return convErr
+}
+
+// callSite returns the file and line of the first caller outside this package.
+func callSite() string {
+	var pcs [16]uintptr
+
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs[:])])
+	for {
+		frame, more := frames.Next()
+		if !strings.HasPrefix(frame.Function, packagePath) {
+			return frame.File + ":" + strconv.Itoa(frame.Line)
+		}
+
+		if !more {
+			return ""
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package safeconversion_test
+
+import (
+	"expvar"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	safe "github.com/bsv-blockchain/go-safe-conversion"
+)
+
+// failureRecord captures the arguments of a single OnFailure call.
+type failureRecord struct {
+	src, dst string
+	value    any
+	err      error
+}
+
+// recordingObserver records every failure it is notified of.
+type recordingObserver struct {
+	failures []failureRecord
+}
+
+// OnFailure records the failure.
+func (o *recordingObserver) OnFailure(src, dst string, value any, err error) {
+	o.failures = append(o.failures, failureRecord{src, dst, value, err})
+}
+
+// installObserver installs o for the duration of the test.
+func installObserver(t testing.TB, o safe.Observer) {
+	t.Helper()
+
+	safe.SetObserver(o)
+	t.Cleanup(func() { safe.SetObserver(nil) })
+}
+
+// TestObserver tests that failed conversions notify the installed observer.
+func TestObserver(t *testing.T) {
+	o := &recordingObserver{}
+	installObserver(t, o)
+
+	_, err := safe.Uint64ToInt32(math.MaxUint64)
+	require.Error(t, err)
+
+	_, err = safe.Uint64ToInt32(7)
+	require.NoError(t, err)
+
+	_, _ = safe.Saturate.IntToUint32(-1)
+	_, _ = safe.CheckedOffset(^uintptr(0), 1, 1)
+
+	require.Len(t, o.failures, 3)
+	assert.Equal(t, failureRecord{"uint64", "int32", uint64(math.MaxUint64), o.failures[0].err}, o.failures[0])
+	require.ErrorIs(t, o.failures[0].err, safe.ErrValueOutOfRange)
+	assert.Equal(t, "int", o.failures[1].src)
+	assert.Equal(t, -1, o.failures[1].value)
+	assert.Equal(t, "uintptr", o.failures[2].dst)
+
+	safe.SetObserver(nil)
+	_, err = safe.IntToUint32(-1)
+	require.Error(t, err)
+	assert.Len(t, o.failures, 3)
+}
+
+// TestExpvarObserver tests that the expvar observer counts failures by conversion and call site.
+func TestExpvarObserver(t *testing.T) {
+	o := safe.NewExpvarObserver(expvar.NewMap("safeconversion_test_failures"))
+	installObserver(t, o)
+
+	for range 3 {
+		_, _ = safe.Uint64ToInt(math.MaxUint64)
+	}
+	_, _ = safe.IntToUint32(-1)
+
+	assert.Equal(t, "3", o.Conversions().Get("uint64_to_int").String())
+	assert.Equal(t, "1", o.Conversions().Get("int_to_uint32").String())
+
+	var sites []string
+	o.CallSites().Do(func(kv expvar.KeyValue) {
+		sites = append(sites, kv.Key)
+	})
+	require.Len(t, sites, 2)
+	for _, site := range sites {
+		assert.Contains(t, site, "observer_test.go:")
+	}
+
+	published := expvar.Get("safeconversion_test_failures").String()
+	assert.True(t, strings.Contains(published, `"uint64_to_int": 3`), published)
+}
+
+// BenchmarkFailureNoObserver benchmarks a failed conversion without an observer installed.
+func BenchmarkFailureNoObserver(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = safe.Int64ToInt32(math.MaxInt64)
+	}
+}
+
+// BenchmarkFailureExpvarObserver benchmarks a failed conversion with the expvar observer installed.
+func BenchmarkFailureExpvarObserver(b *testing.B) {
+	installObserver(b, safe.NewExpvarObserver(new(expvar.Map).Init()))
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = safe.Int64ToInt32(math.MaxInt64)
+	}
+}
@@ -0,0 +1,304 @@
+package safeconversion
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"time"
+	"unicode/utf8"
+)
+
+// Policy selects how a conversion behaves when its value does not fit in the target type,
+// so the same call sites can be strict in one subsystem and lenient in another. Its methods
+// mirror the package functions and return the same results whenever a conversion succeeds.
+type Policy uint8
+
+const (
+	// Strict returns the error of the failed conversion, exactly like the package functions
+	Strict Policy = iota
+
+	// Saturate clamps the value to the nearest representable value and never returns an error
+	Saturate
+
+	// Wrap truncates the value like a Go type conversion and never returns an error
+	Wrap
+
+	// Panic panics with the error of the failed conversion
+	Panic
+)
+
+// String returns the name of the policy.
+func (p Policy) String() string {
+	switch p {
+	case Strict:
+		return "Strict"
+	case Saturate:
+		return "Saturate"
+	case Wrap:
+		return "Wrap"
+	case Panic:
+		return "Panic"
+	default:
+		return fmt.Sprintf("Policy(%d)", uint8(p))
+	}
+}
+
+// IntToUint32 converts value as IntToUint32 does, applying the policy on failure.
+func (p Policy) IntToUint32(value int) (uint32, error) {
+	return policyConvert(p, IntToUint32, value)
+}
+
+// Uint64ToUint32 converts value as Uint64ToUint32 does, applying the policy on failure.
+func (p Policy) Uint64ToUint32(value uint64) (uint32, error) {
+	return policyConvert(p, Uint64ToUint32, value)
+}
+
+// Int64ToUint64 converts value as Int64ToUint64 does, applying the policy on failure.
+func (p Policy) Int64ToUint64(value int64) (uint64, error) {
+	return policyConvert(p, Int64ToUint64, value)
+}
+
+// IntToUint64 converts value as IntToUint64 does, applying the policy on failure.
+func (p Policy) IntToUint64(value int) (uint64, error) {
+	return policyConvert(p, IntToUint64, value)
+}
+
+// Uint64ToInt converts value as Uint64ToInt does, applying the policy on failure.
+func (p Policy) Uint64ToInt(value uint64) (int, error) {
+	return policyConvert(p, Uint64ToInt, value)
+}
+
+// Int64ToInt32 converts value as Int64ToInt32 does, applying the policy on failure.
+func (p Policy) Int64ToInt32(value int64) (int32, error) {
+	return policyConvert(p, Int64ToInt32, value)
+}
+
+// IntToInt32 converts value as IntToInt32 does, applying the policy on failure.
+func (p Policy) IntToInt32(value int) (int32, error) {
+	return policyConvert(p, IntToInt32, value)
+}
+
+// Int32ToUint32 converts value as Int32ToUint32 does, applying the policy on failure.
+func (p Policy) Int32ToUint32(value int32) (uint32, error) {
+	return policyConvert(p, Int32ToUint32, value)
+}
+
+// Int64ToUint32 converts value as Int64ToUint32 does, applying the policy on failure.
+func (p Policy) Int64ToUint32(value int64) (uint32, error) {
+	return policyConvert(p, Int64ToUint32, value)
+}
+
+// BigWordToUint32 converts value as BigWordToUint32 does, applying the policy on failure.
+func (p Policy) BigWordToUint32(value big.Word) (uint32, error) {
+	return policyConvert(p, BigWordToUint32, value)
+}
+
+// IntToUint16 converts value as IntToUint16 does, applying the policy on failure.
+func (p Policy) IntToUint16(value int) (uint16, error) {
+	return policyConvert(p, IntToUint16, value)
+}
+
+// IntToInt16 converts value as IntToInt16 does, applying the policy on failure.
+func (p Policy) IntToInt16(value int) (int16, error) {
+	return policyConvert(p, IntToInt16, value)
+}
+
+// UintToUint32 converts value as UintToUint32 does, applying the policy on failure.
+func (p Policy) UintToUint32(value uint) (uint32, error) {
+	return policyConvert(p, UintToUint32, value)
+}
+
+// TimeToUint32 converts value as TimeToUint32 does, applying the policy on failure.
+// Saturate clamps times before the epoch to 0 and times after 2106 to math.MaxUint32.
+func (p Policy) TimeToUint32(value time.Time) (uint32, error) {
+	result, err := TimeToUint32(value)
+	if err == nil || p.failed(err) {
+		return result, err
+	}
+
+	timestamp := value.Unix()
+	if p == Wrap {
+		return uint32(timestamp), nil //nolint:gosec // truncation is the requested behavior
+	}
+
+	if timestamp < 0 {
+		return 0, nil
+	}
+
+	return math.MaxUint32, nil
+}
+
+// Uint32ToUint8 converts value as Uint32ToUint8 does, applying the policy on failure.
+func (p Policy) Uint32ToUint8(value uint32) (uint8, error) {
+	return policyConvert(p, Uint32ToUint8, value)
+}
+
+// UintptrToInt converts value as UintptrToInt does, applying the policy on failure.
+func (p Policy) UintptrToInt(value uintptr) (int, error) {
+	return policyConvert(p, UintptrToInt, value)
+}
+
+// IntToUintptr converts value as IntToUintptr does, applying the policy on failure.
+func (p Policy) IntToUintptr(value int) (uintptr, error) {
+	return policyConvert(p, IntToUintptr, value)
+}
+
+// Int64ToUintptr converts value as Int64ToUintptr does, applying the policy on failure.
+func (p Policy) Int64ToUintptr(value int64) (uintptr, error) {
+	return policyConvert(p, Int64ToUintptr, value)
+}
+
+// Uint64ToUintptr converts value as Uint64ToUintptr does, applying the policy on failure.
+func (p Policy) Uint64ToUintptr(value uint64) (uintptr, error) {
+	return policyConvert(p, Uint64ToUintptr, value)
+}
+
+// CheckedOffset computes base + index*elemSize as CheckedOffset does, applying the policy on failure.
+// Saturate returns the last address of the address space, and Wrap returns the address
+// computed with wrapping arithmetic.
+func (p Policy) CheckedOffset(base uintptr, index, elemSize uint64) (uintptr, error) {
+	result, err := CheckedOffset(base, index, elemSize)
+	if err == nil || p.failed(err) {
+		return result, err
+	}
+
+	if p == Wrap {
+		return base + uintptr(index*elemSize), nil //nolint:gosec // truncation is the requested behavior
+	}
+
+	return ^uintptr(0), nil
+}
+
+// Uint64ToInt64 converts value as Uint64ToInt64 does, applying the policy on failure.
+func (p Policy) Uint64ToInt64(value uint64) (int64, error) {
+	return policyConvert(p, Uint64ToInt64, value)
+}
+
+// Uint32ToInt32 converts value as Uint32ToInt32 does, applying the policy on failure.
+func (p Policy) Uint32ToInt32(value uint32) (int32, error) {
+	return policyConvert(p, Uint32ToInt32, value)
+}
+
+// Uint64ToInt32 converts value as Uint64ToInt32 does, applying the policy on failure.
+func (p Policy) Uint64ToInt32(value uint64) (int32, error) {
+	return policyConvert(p, Uint64ToInt32, value)
+}
+
+// Uint32ToInt64 converts value as Uint32ToInt64 does, applying the policy on failure.
+func (p Policy) Uint32ToInt64(value uint32) (int64, error) {
+	return policyConvert(p, Uint32ToInt64, value)
+}
+
+// Uint32ToUint64 converts value as Uint32ToUint64 does, applying the policy on failure.
+func (p Policy) Uint32ToUint64(value uint32) (uint64, error) {
+	return policyConvert(p, Uint32ToUint64, value)
+}
+
+// Uint64ToUint16 converts value as Uint64ToUint16 does, applying the policy on failure.
+func (p Policy) Uint64ToUint16(value uint64) (uint16, error) {
+	return policyConvert(p, Uint64ToUint16, value)
+}
+
+// IntToByte converts value as IntToByte does, applying the policy on failure.
+func (p Policy) IntToByte(value int) (byte, error) {
+	return policyConvert(p, IntToByte, value)
+}
+
+// IntToRune converts value as IntToRune does, applying the policy on failure.
+// Saturate returns utf8.RuneError for invalid code points, and Wrap returns rune(value).
+func (p Policy) IntToRune(value int) (rune, error) {
+	result, err := IntToRune(value)
+	if err == nil || p.failed(err) {
+		return result, err
+	}
+
+	if p == Wrap {
+		return rune(value), nil //nolint:gosec // truncation is the requested behavior
+	}
+
+	return utf8.RuneError, nil
+}
+
+// Uint32ToRune converts value as Uint32ToRune does, applying the policy on failure.
+// Saturate returns utf8.RuneError for invalid code points, and Wrap returns rune(value).
+func (p Policy) Uint32ToRune(value uint32) (rune, error) {
+	result, err := Uint32ToRune(value)
+	if err == nil || p.failed(err) {
+		return result, err
+	}
+
+	if p == Wrap {
+		return rune(value), nil //nolint:gosec // wrapping is the requested behavior
+	}
+
+	return utf8.RuneError, nil
+}
+
+// OpcodeFromInt converts value as OpcodeFromInt does, applying the policy on failure.
+// Opcodes have no meaningful wrapped form, so both Saturate and Wrap clamp value to the
+// range [-1, 16] and return OP_1NEGATE or OP_16.
+func (p Policy) OpcodeFromInt(value int) (byte, error) {
+	result, err := OpcodeFromInt(value)
+	if err == nil || p.failed(err) {
+		return result, err
+	}
+
+	if value < 0 {
+		return opcodeOp1Negate, nil
+	}
+
+	return opcodeOp1 + 15, nil
+}
+
+// failed reports whether err should be returned to the caller, which is the case for
+// Strict and unknown policies. It panics under the Panic policy.
+func (p Policy) failed(err error) bool {
+	switch p {
+	case Saturate, Wrap:
+		return false
+	case Panic:
+		panic(err)
+	default:
+		return true
+	}
+}
+
+// Must returns value, panicking if err is not nil. It wraps a conversion whose failure
+// is a programming error, and can be used directly in expressions:
+//
+//	n := Must(Int64ToUint32(v))
+func Must[T any](value T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+
+	return value
+}
+
+// policyConvert applies fn to value and resolves a failure according to p.
+func policyConvert[S, D Integer](p Policy, fn func(S) (D, error), value S) (D, error) {
+	result, err := fn(value)
+	if err == nil || p.failed(err) {
+		return result, err
+	}
+
+	if p == Wrap {
+		return D(value), nil
+	}
+
+	return saturate[D](value), nil
+}
+
+// saturate converts value to D, clamping it to the range of D.
+func saturate[D, S Integer](value S) D {
+	neg, magnitude := magnitudeOf(value)
+	if result, err := fromMagnitude[D](neg, magnitude); err == nil {
+		return result
+	}
+
+	if neg {
+		return minOf[D]()
+	}
+
+	return maxOf[D]()
+}
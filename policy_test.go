@@ -0,0 +1,156 @@
+package safeconversion_test
+
+import (
+	"math"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	safe "github.com/bsv-blockchain/go-safe-conversion"
+)
+
+// TestPolicyInt64ToInt32 tests each policy against a narrowing conversion.
+func TestPolicyInt64ToInt32(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  safe.Policy
+		input   int64
+		expect  int32
+		wantErr error
+	}{
+		{"strict in range", safe.Strict, 42, 42, nil},
+		{"strict too large", safe.Strict, math.MaxInt32 + 1, 0, safe.ErrValueOutOfRange},
+		{"saturate in range", safe.Saturate, -42, -42, nil},
+		{"saturate too large", safe.Saturate, math.MaxInt64, math.MaxInt32, nil},
+		{"saturate too small", safe.Saturate, math.MinInt64, math.MinInt32, nil},
+		{"wrap too large", safe.Wrap, math.MaxInt32 + 1, math.MinInt32, nil},
+		{"wrap too small", safe.Wrap, math.MinInt32 - 1, math.MaxInt32, nil},
+		{"panic in range", safe.Panic, 7, 7, nil},
+		{"unknown policy", safe.Policy(42), math.MaxInt64, 0, safe.ErrValueOutOfRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.policy.Int64ToInt32(tt.input)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expect, result)
+		})
+	}
+}
+
+// TestPolicyStrictMatchesFunctions tests that Strict returns exactly what the package functions return.
+func TestPolicyStrictMatchesFunctions(t *testing.T) {
+	for _, v := range []int{math.MinInt, -1, 0, 1, math.MaxUint16, math.MaxInt} {
+		expect, expectErr := safe.IntToUint16(v)
+		result, err := safe.Strict.IntToUint16(v)
+		assert.Equal(t, expect, result)
+		assert.Equal(t, expectErr, err)
+	}
+}
+
+// TestPolicyUnsigned tests saturating and wrapping conversions to unsigned types.
+func TestPolicyUnsigned(t *testing.T) {
+	result, err := safe.Saturate.IntToUint32(-5)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(0), result)
+
+	result, err = safe.Wrap.IntToUint32(-1)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(math.MaxUint32), result)
+
+	result, err = safe.Saturate.Uint64ToUint32(math.MaxUint64)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(math.MaxUint32), result)
+
+	b, err := safe.Wrap.IntToByte(0x1ff)
+	require.NoError(t, err)
+	assert.Equal(t, byte(0xff), b)
+
+	i, err := safe.Saturate.Uint64ToInt64(math.MaxUint64)
+	require.NoError(t, err)
+	assert.Equal(t, int64(math.MaxInt64), i)
+}
+
+// TestPolicySpecialConversions tests the policies of conversions that are not plain integer narrowing.
+func TestPolicySpecialConversions(t *testing.T) {
+	ts, err := safe.Saturate.TimeToUint32(time.Unix(-1, 0))
+	require.NoError(t, err)
+	assert.Equal(t, uint32(0), ts)
+
+	ts, err = safe.Saturate.TimeToUint32(time.Unix(math.MaxUint32+1, 0))
+	require.NoError(t, err)
+	assert.Equal(t, uint32(math.MaxUint32), ts)
+
+	ts, err = safe.Wrap.TimeToUint32(time.Unix(math.MaxUint32+2, 0))
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), ts)
+
+	address, err := safe.Saturate.CheckedOffset(^uintptr(0)-1, 4, 1)
+	require.NoError(t, err)
+	assert.Equal(t, ^uintptr(0), address)
+
+	address, err = safe.Wrap.CheckedOffset(^uintptr(0)-1, 4, 1)
+	require.NoError(t, err)
+	assert.Equal(t, uintptr(2), address)
+
+	r, err := safe.Saturate.IntToRune(0xD800)
+	require.NoError(t, err)
+	assert.Equal(t, utf8.RuneError, r)
+
+	r, err = safe.Wrap.Uint32ToRune(0xD800)
+	require.NoError(t, err)
+	assert.Equal(t, rune(0xD800), r)
+
+	_, err = safe.Strict.Uint32ToRune(0xD800)
+	require.ErrorIs(t, err, safe.ErrInvalidRune)
+
+	op, err := safe.Saturate.OpcodeFromInt(-5)
+	require.NoError(t, err)
+	assert.Equal(t, byte(0x4f), op)
+
+	op, err = safe.Wrap.OpcodeFromInt(100)
+	require.NoError(t, err)
+	assert.Equal(t, byte(0x60), op)
+}
+
+// TestPolicyPanic tests that the Panic policy panics with the conversion error.
+func TestPolicyPanic(t *testing.T) {
+	defer func() {
+		err, ok := recover().(error)
+		require.True(t, ok)
+		require.ErrorIs(t, err, safe.ErrValueOutOfRange)
+	}()
+
+	_, _ = safe.Panic.Int64ToInt32(math.MaxInt64)
+	t.Fatal("expected a panic")
+}
+
+// TestMust tests that Must returns the converted value and panics on failure.
+func TestMust(t *testing.T) {
+	assert.Equal(t, uint32(42), safe.Must(safe.Int64ToUint32(42)))
+	assert.PanicsWithError(t, "value out of range (uint32): 4294967296", func() {
+		safe.Must(safe.Int64ToUint32(math.MaxUint32 + 1))
+	})
+}
+
+// TestPolicyString tests the names of the policies.
+func TestPolicyString(t *testing.T) {
+	assert.Equal(t, "Strict", safe.Strict.String())
+	assert.Equal(t, "Saturate", safe.Saturate.String())
+	assert.Equal(t, "Wrap", safe.Wrap.String())
+	assert.Equal(t, "Panic", safe.Panic.String())
+	assert.Equal(t, "Policy(9)", safe.Policy(9).String())
+}
+
+// TestPolicyCoversAllConversions tests that Policy has a method for every conversion in safe_conversion.go.
+func TestPolicyCoversAllConversions(t *testing.T) {
+	assertCoversConversions(t, safe.Strict)
+}
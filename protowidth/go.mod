@@ -0,0 +1,15 @@
+module github.com/bsv-blockchain/go-safe-conversion/protowidth
+
+go 1.25.0
+
+// The root module is required at a commit that has every function protowidth uses. Local
+// development builds against the working tree through the go.work file at the repository root.
+// When releasing, tag the root module first, then update this requirement to that tag, run
+// go mod tidy with GOWORK=off, and tag protowidth/vX.Y.Z.
+require (
+	github.com/bsv-blockchain/go-safe-conversion v0.0.0-20261019055446-d1ab5733160d
+	github.com/stretchr/testify v1.12.0
+	google.golang.org/protobuf v1.36.12
+)
+
+require gopkg.in/yaml.v3 v3.0.1 // indirect
@@ -0,0 +1,257 @@
+// Package protowidth validates the integer fields of protobuf messages against the Go types
+// they are narrowed to. Protobuf has no 8-bit or 16-bit integer types, so messages carry such
+// values in int32, uint32, int64 or uint64 fields that must be range checked on receipt.
+//
+// The target width of a field is declared in a Schema keyed by the full name of the field,
+// or in a string extension of google.protobuf.FieldOptions:
+//
+//	extend google.protobuf.FieldOptions {
+//		string width = 50000;
+//	}
+//
+//	message TxOut {
+//		uint32 script_version = 1 [(width) = "uint16"];
+//	}
+//
+// Validation walks the message with protoreflect, including nested messages, repeated fields
+// and map values, and reports each field that does not fit with a *safeconversion.FieldError
+// whose path locates it, such as "outputs[2].script_version".
+//
+// The package is a separate module, so that only its users depend on google.golang.org/protobuf.
+package protowidth
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	safeconversion "github.com/bsv-blockchain/go-safe-conversion"
+)
+
+// Width is the Go integer type a protobuf integer field is narrowed to.
+type Width uint8
+
+const (
+	// Int8 narrows a field to int8
+	Int8 Width = iota + 1
+
+	// Int16 narrows a field to int16
+	Int16
+
+	// Int32 narrows a field to int32
+	Int32
+
+	// Int64 narrows a field to int64
+	Int64
+
+	// Uint8 narrows a field to uint8
+	Uint8
+
+	// Uint16 narrows a field to uint16
+	Uint16
+
+	// Uint32 narrows a field to uint32
+	Uint32
+
+	// Uint64 narrows a field to uint64
+	Uint64
+)
+
+// widthNames maps each Width to the name of its Go type.
+func widthNames() map[Width]string {
+	return map[Width]string{
+		Int8:   "int8",
+		Int16:  "int16",
+		Int32:  "int32",
+		Int64:  "int64",
+		Uint8:  "uint8",
+		Uint16: "uint16",
+		Uint32: "uint32",
+		Uint64: "uint64",
+	}
+}
+
+// String returns the name of the Go type, such as "uint16".
+func (w Width) String() string {
+	if name, ok := widthNames()[w]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("Width(%d)", uint8(w))
+}
+
+// ParseWidth returns the Width named by a Go integer type, such as "uint16".
+// Returns an error if s does not name a fixed-width integer type.
+func ParseWidth(s string) (Width, error) {
+	for w, name := range widthNames() {
+		if name == s {
+			return w, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w (width): %q", safeconversion.ErrInvalidSyntax, s)
+}
+
+// Schema maps the full name of a field, such as "tx.v1.TxOut.script_version", to its width.
+type Schema map[protoreflect.FullName]Width
+
+// Validate validates m against the schema.
+func (s Schema) Validate(m proto.Message) error {
+	return Validator{Schema: s}.Validate(m)
+}
+
+// Validator validates messages against the widths declared in a schema and in field options.
+type Validator struct {
+	// Schema declares the widths of fields by full name. It takes precedence over Option.
+	Schema Schema
+
+	// Option, when set, is a string extension of google.protobuf.FieldOptions whose value
+	// names the width of the field, such as "uint16".
+	Option protoreflect.ExtensionType
+}
+
+// Validate walks m and checks every populated integer field with a declared width.
+// Returns nil if every field fits, or the errors of all fields that do not, joined with
+// errors.Join and labeled with their paths. A width declared for a field that is not an
+// integer, or an option that does not name a width, is reported as an error as well.
+func (v Validator) Validate(m proto.Message) error {
+	if m == nil {
+		return nil
+	}
+
+	return errors.Join(v.message(m.ProtoReflect(), "", nil)...)
+}
+
+// message appends the errors of the fields of m to errs, prefixing field names with prefix.
+// Fields are visited in declaration order, so that errors are reported deterministically.
+func (v Validator) message(m protoreflect.Message, prefix string, errs []error) []error {
+	fields := m.Descriptor().Fields()
+
+	for i := range fields.Len() {
+		fd := fields.Get(i)
+		if !m.Has(fd) {
+			continue
+		}
+
+		path := prefix + string(fd.Name())
+		value := m.Get(fd)
+
+		switch {
+		case fd.IsList():
+			list := value.List()
+			for j := range list.Len() {
+				errs = v.value(fd, fd, list.Get(j), path+"["+strconv.Itoa(j)+"]", errs)
+			}
+		case fd.IsMap():
+			value.Map().Range(func(key protoreflect.MapKey, item protoreflect.Value) bool {
+				errs = v.value(fd, fd.MapValue(), item, path+"["+key.String()+"]", errs)
+				return true
+			})
+		default:
+			errs = v.value(fd, fd, value, path, errs)
+		}
+	}
+
+	return errs
+}
+
+// value appends the errors of a single value at path to errs. The element descriptor describes
+// the value itself, while field is the field declaring its width; they differ for map values,
+// whose width is declared on the map field.
+// A width declared on a message field is reported rather than applied to its contents.
+func (v Validator) value(field, element protoreflect.FieldDescriptor, value protoreflect.Value, path string, errs []error) []error {
+	width, declared, err := v.width(field)
+	if err == nil && !declared && element.Message() != nil {
+		return v.message(value.Message(), path+".", errs)
+	}
+
+	if err == nil && declared {
+		err = check(field.FullName(), element.Kind(), width, value)
+	}
+
+	if err != nil {
+		errs = append(errs, safeconversion.WithField(path, err))
+	}
+
+	return errs
+}
+
+// width returns the width declared for fd, and whether one is declared.
+func (v Validator) width(fd protoreflect.FieldDescriptor) (Width, bool, error) {
+	if width, ok := v.Schema[fd.FullName()]; ok {
+		return width, true, nil
+	}
+
+	if v.Option == nil {
+		return 0, false, nil
+	}
+
+	options := fd.Options()
+	if options == nil || !options.ProtoReflect().IsValid() || !proto.HasExtension(options, v.Option) {
+		return 0, false, nil
+	}
+
+	name, ok := proto.GetExtension(options, v.Option).(string)
+	if !ok {
+		return 0, false, fmt.Errorf("%w for width option %s", safeconversion.ErrUnsupportedType, v.Option.TypeDescriptor().FullName())
+	}
+
+	width, err := ParseWidth(name)
+
+	return width, err == nil, err
+}
+
+// check checks that value, of the given kind in the named field, fits in width.
+func check(field protoreflect.FullName, kind protoreflect.Kind, width Width, value protoreflect.Value) error {
+	var signed bool
+
+	switch kind {
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		signed = true
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+	default:
+		return fmt.Errorf("%w for width %s: %s field %s", safeconversion.ErrUnsupportedType, width, kind, field)
+	}
+
+	switch width {
+	case Int8:
+		return narrow(signed, value, safeconversion.Int64ToInt8, safeconversion.Uint64ToInt8)
+	case Int16:
+		return narrow(signed, value, safeconversion.Int64ToInt16, safeconversion.Uint64ToInt16)
+	case Int32:
+		return narrow(signed, value, safeconversion.Int64ToInt32, safeconversion.Uint64ToInt32)
+	case Int64:
+		return narrow(signed, value, exact[int64], safeconversion.Uint64ToInt64)
+	case Uint8:
+		return narrow(signed, value, safeconversion.Int64ToUint8, safeconversion.Uint64ToUint8)
+	case Uint16:
+		return narrow(signed, value, safeconversion.Int64ToUint16, safeconversion.Uint64ToUint16)
+	case Uint32:
+		return narrow(signed, value, safeconversion.Int64ToUint32, safeconversion.Uint64ToUint32)
+	case Uint64:
+		return narrow(signed, value, safeconversion.Int64ToUint64, exact[uint64])
+	default:
+		return fmt.Errorf("%w for field %s: %s", safeconversion.ErrUnsupportedType, field, width)
+	}
+}
+
+// narrow converts value with fromInt if the field is signed, and with fromUint otherwise.
+func narrow[S, U any](signed bool, value protoreflect.Value, fromInt func(int64) (S, error), fromUint func(uint64) (U, error)) error {
+	var err error
+	if signed {
+		_, err = fromInt(value.Int())
+	} else {
+		_, err = fromUint(value.Uint())
+	}
+
+	return err
+}
+
+// exact is the conversion of a value to its own type, which always succeeds.
+func exact[T any](value T) (T, error) {
+	return value, nil
+}
@@ -0,0 +1,336 @@
+package protowidth_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	safe "github.com/bsv-blockchain/go-safe-conversion"
+	"github.com/bsv-blockchain/go-safe-conversion/protowidth"
+)
+
+// testFile builds a file with a TxOut message, a Tx message holding nested, repeated and map
+// fields, and a string width extension of FieldOptions. Widths are declared on its fields
+// through withWidth.
+func testFile(t *testing.T) protoreflect.FileDescriptor {
+	t.Helper()
+
+	field := func(name string, number int32, kind descriptorpb.FieldDescriptorProto_Type) *descriptorpb.FieldDescriptorProto {
+		return &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(name),
+			Number:   proto.Int32(number),
+			Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:     kind.Enum(),
+			JsonName: proto.String(name),
+		}
+	}
+	repeated := func(f *descriptorpb.FieldDescriptorProto) *descriptorpb.FieldDescriptorProto {
+		f.Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+		return f
+	}
+	message := func(f *descriptorpb.FieldDescriptorProto, typeName string) *descriptorpb.FieldDescriptorProto {
+		f.TypeName = proto.String(typeName)
+		return f
+	}
+
+	widthOption := field("width", 50000, descriptorpb.FieldDescriptorProto_TYPE_STRING)
+	widthOption.Extendee = proto.String(".google.protobuf.FieldOptions")
+
+	fdp := &descriptorpb.FileDescriptorProto{
+		Name:       proto.String("tx/v1/tx.proto"),
+		Package:    proto.String("tx.v1"),
+		Syntax:     proto.String("proto3"),
+		Dependency: []string{"google/protobuf/descriptor.proto"},
+		Extension:  []*descriptorpb.FieldDescriptorProto{widthOption},
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name: proto.String("TxOut"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("script_version", 1, descriptorpb.FieldDescriptorProto_TYPE_UINT32),
+					field("satoshis", 2, descriptorpb.FieldDescriptorProto_TYPE_UINT64),
+					field("script", 3, descriptorpb.FieldDescriptorProto_TYPE_BYTES),
+				},
+			},
+			{
+				Name: proto.String("Tx"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("version", 1, descriptorpb.FieldDescriptorProto_TYPE_INT64),
+					field("lock_time", 2, descriptorpb.FieldDescriptorProto_TYPE_SINT32),
+					repeated(message(field("outputs", 3, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE), ".tx.v1.TxOut")),
+					repeated(field("flags", 4, descriptorpb.FieldDescriptorProto_TYPE_UINT32)),
+					repeated(message(field("fees", 5, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE), ".tx.v1.Tx.FeesEntry")),
+					message(field("change", 6, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE), ".tx.v1.TxOut"),
+				},
+				NestedType: []*descriptorpb.DescriptorProto{
+					{
+						Name: proto.String("FeesEntry"),
+						Field: []*descriptorpb.FieldDescriptorProto{
+							field("key", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING),
+							field("value", 2, descriptorpb.FieldDescriptorProto_TYPE_INT32),
+						},
+						Options: &descriptorpb.MessageOptions{MapEntry: proto.Bool(true)},
+					},
+				},
+			},
+		},
+	}
+
+	file, err := protodesc.NewFile(fdp, protoregistry.GlobalFiles)
+	require.NoError(t, err)
+
+	return file
+}
+
+// withWidth returns a copy of file in which the named fields declare a width option.
+func withWidth(t *testing.T, file protoreflect.FileDescriptor, widths map[string]string) (protoreflect.FileDescriptor, protoreflect.ExtensionType) {
+	t.Helper()
+
+	xt := dynamicpb.NewExtensionType(file.Extensions().ByName("width"))
+	fdp := protodesc.ToFileDescriptorProto(file)
+
+	for _, msg := range fdp.GetMessageType() {
+		for _, f := range msg.GetField() {
+			if width, ok := widths[msg.GetName()+"."+f.GetName()]; ok {
+				f.Options = &descriptorpb.FieldOptions{}
+				proto.SetExtension(f.Options, xt, width)
+			}
+		}
+	}
+
+	result, err := protodesc.NewFile(fdp, protoregistry.GlobalFiles)
+	require.NoError(t, err)
+
+	return result, xt
+}
+
+// newTx returns a Tx message of file populated by the given fields.
+func newTx(file protoreflect.FileDescriptor, version int64, lockTime int32, scriptVersions []uint32, flags []uint32, fees map[string]int32) proto.Message {
+	txDesc := file.Messages().ByName("Tx")
+	outDesc := file.Messages().ByName("TxOut")
+
+	tx := dynamicpb.NewMessage(txDesc)
+	tx.Set(txDesc.Fields().ByName("version"), protoreflect.ValueOfInt64(version))
+	tx.Set(txDesc.Fields().ByName("lock_time"), protoreflect.ValueOfInt32(lockTime))
+
+	outputs := tx.Mutable(txDesc.Fields().ByName("outputs")).List()
+	for _, scriptVersion := range scriptVersions {
+		out := dynamicpb.NewMessage(outDesc)
+		out.Set(outDesc.Fields().ByName("script_version"), protoreflect.ValueOfUint32(scriptVersion))
+		out.Set(outDesc.Fields().ByName("satoshis"), protoreflect.ValueOfUint64(1000))
+		outputs.Append(protoreflect.ValueOfMessage(out))
+	}
+
+	flagList := tx.Mutable(txDesc.Fields().ByName("flags")).List()
+	for _, flag := range flags {
+		flagList.Append(protoreflect.ValueOfUint32(flag))
+	}
+
+	feeMap := tx.Mutable(txDesc.Fields().ByName("fees")).Map()
+	for key, fee := range fees {
+		feeMap.Set(protoreflect.ValueOfString(key).MapKey(), protoreflect.ValueOfInt32(fee))
+	}
+
+	return tx
+}
+
+// fieldPaths returns the field paths of the errors joined in err.
+func fieldPaths(t *testing.T, err error) []string {
+	t.Helper()
+
+	var joined interface{ Unwrap() []error }
+	require.ErrorAs(t, err, &joined)
+
+	paths := make([]string, 0, len(joined.Unwrap()))
+	for _, e := range joined.Unwrap() {
+		var fieldErr *safe.FieldError
+		require.ErrorAs(t, e, &fieldErr)
+		paths = append(paths, fieldErr.Field)
+	}
+
+	return paths
+}
+
+// TestParseWidth tests parsing width names.
+func TestParseWidth(t *testing.T) {
+	for _, width := range []protowidth.Width{
+		protowidth.Int8, protowidth.Int16, protowidth.Int32, protowidth.Int64,
+		protowidth.Uint8, protowidth.Uint16, protowidth.Uint32, protowidth.Uint64,
+	} {
+		t.Run(width.String(), func(t *testing.T) {
+			parsed, err := protowidth.ParseWidth(width.String())
+			require.NoError(t, err)
+			assert.Equal(t, width, parsed)
+		})
+	}
+
+	_, err := protowidth.ParseWidth("uint128")
+	require.ErrorIs(t, err, safe.ErrInvalidSyntax)
+	assert.Equal(t, "Width(0)", protowidth.Width(0).String())
+}
+
+// TestSchemaValidate tests validating messages against widths declared in a schema.
+func TestSchemaValidate(t *testing.T) {
+	file := testFile(t)
+	schema := protowidth.Schema{
+		"tx.v1.Tx.version":           protowidth.Int32,
+		"tx.v1.Tx.lock_time":         protowidth.Uint32,
+		"tx.v1.TxOut.script_version": protowidth.Uint16,
+		"tx.v1.Tx.flags":             protowidth.Uint8,
+		"tx.v1.Tx.fees":              protowidth.Int16,
+	}
+
+	tests := []struct {
+		name  string
+		tx    proto.Message
+		paths []string
+		err   error
+	}{
+		{
+			name: "all fields fit",
+			tx:   newTx(file, 2, 0, []uint32{0, 65535}, []uint32{255}, map[string]int32{"miner": -32768}),
+		},
+		{
+			name:  "signed field above range",
+			tx:    newTx(file, 1<<31, 0, nil, nil, nil),
+			paths: []string{"version"},
+			err:   safe.ErrValueOutOfRange,
+		},
+		{
+			name:  "negative value to unsigned width",
+			tx:    newTx(file, 1, -1, nil, nil, nil),
+			paths: []string{"lock_time"},
+			err:   safe.ErrNegativeValueCannotBeConverted,
+		},
+		{
+			name:  "repeated message field",
+			tx:    newTx(file, 1, 0, []uint32{1, 65536, 2, 1 << 20}, nil, nil),
+			paths: []string{"outputs[1].script_version", "outputs[3].script_version"},
+			err:   safe.ErrValueOutOfRange,
+		},
+		{
+			name:  "repeated scalar field",
+			tx:    newTx(file, 1, 0, nil, []uint32{1, 256}, nil),
+			paths: []string{"flags[1]"},
+			err:   safe.ErrValueOutOfRange,
+		},
+		{
+			name:  "map value",
+			tx:    newTx(file, 1, 0, nil, nil, map[string]int32{"miner": 40000}),
+			paths: []string{"fees[miner]"},
+			err:   safe.ErrValueOutOfRange,
+		},
+		{
+			name:  "errors in declaration order",
+			tx:    newTx(file, -1<<40, -5, []uint32{70000}, nil, nil),
+			paths: []string{"version", "lock_time", "outputs[0].script_version"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := schema.Validate(tt.tx)
+			if tt.paths == nil {
+				require.NoError(t, err)
+				return
+			}
+
+			assert.Equal(t, tt.paths, fieldPaths(t, err))
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			}
+		})
+	}
+}
+
+// TestSchemaValidateNested tests validating a singular nested message.
+func TestSchemaValidateNested(t *testing.T) {
+	file := testFile(t)
+	tx := newTx(file, 1, 0, nil, nil, nil)
+
+	txDesc := file.Messages().ByName("Tx")
+	outDesc := file.Messages().ByName("TxOut")
+	change := dynamicpb.NewMessage(outDesc)
+	change.Set(outDesc.Fields().ByName("script_version"), protoreflect.ValueOfUint32(300))
+	tx.ProtoReflect().Set(txDesc.Fields().ByName("change"), protoreflect.ValueOfMessage(change))
+
+	err := protowidth.Schema{"tx.v1.TxOut.script_version": protowidth.Uint8}.Validate(tx)
+	require.ErrorIs(t, err, safe.ErrValueOutOfRange)
+	assert.Equal(t, "change.script_version: value out of range (uint8): 300", err.Error())
+
+	require.NoError(t, protowidth.Schema{"tx.v1.TxOut.script_version": protowidth.Uint16}.Validate(tx))
+	require.NoError(t, protowidth.Schema{}.Validate(nil))
+}
+
+// TestSchemaValidateUnsupported tests declaring a width for a field that is not an integer.
+func TestSchemaValidateUnsupported(t *testing.T) {
+	file := testFile(t)
+	tx := newTx(file, 1, 0, nil, nil, nil)
+
+	txDesc := file.Messages().ByName("Tx")
+	outDesc := file.Messages().ByName("TxOut")
+	out := dynamicpb.NewMessage(outDesc)
+	out.Set(outDesc.Fields().ByName("script"), protoreflect.ValueOfBytes([]byte{0x51}))
+	tx.ProtoReflect().Set(txDesc.Fields().ByName("change"), protoreflect.ValueOfMessage(out))
+
+	err := protowidth.Schema{"tx.v1.TxOut.script": protowidth.Uint8}.Validate(tx)
+	require.ErrorIs(t, err, safe.ErrUnsupportedType)
+	assert.Equal(t, []string{"change.script"}, fieldPaths(t, err))
+}
+
+// TestSchemaValidateMessageWidth tests declaring a width for a message field.
+func TestSchemaValidateMessageWidth(t *testing.T) {
+	file := testFile(t)
+	tx := newTx(file, 1, 0, []uint32{1 << 20}, nil, nil)
+
+	txDesc := file.Messages().ByName("Tx")
+	tx.ProtoReflect().Set(txDesc.Fields().ByName("change"), protoreflect.ValueOfMessage(dynamicpb.NewMessage(file.Messages().ByName("TxOut"))))
+
+	err := protowidth.Schema{
+		"tx.v1.Tx.change":            protowidth.Uint16,
+		"tx.v1.Tx.outputs":           protowidth.Uint16,
+		"tx.v1.TxOut.script_version": protowidth.Uint8,
+	}.Validate(tx)
+	require.ErrorIs(t, err, safe.ErrUnsupportedType)
+	require.NotErrorIs(t, err, safe.ErrValueOutOfRange)
+	assert.Equal(t, []string{"outputs[0]", "change"}, fieldPaths(t, err))
+}
+
+// TestValidatorOption tests validating messages against widths declared in field options.
+func TestValidatorOption(t *testing.T) {
+	file, xt := withWidth(t, testFile(t), map[string]string{
+		"TxOut.script_version": "uint16",
+		"Tx.lock_time":         "uint32",
+		"Tx.fees":              "int8",
+	})
+
+	validator := protowidth.Validator{Option: xt}
+	require.NoError(t, validator.Validate(newTx(file, 1<<40, 0, []uint32{65535}, nil, map[string]int32{"a": 127})))
+
+	err := validator.Validate(newTx(file, 1, -1, []uint32{65536}, nil, map[string]int32{"a": 128}))
+	assert.Equal(t, []string{"lock_time", "outputs[0].script_version", "fees[a]"}, fieldPaths(t, err))
+
+	// The schema takes precedence over the option.
+	validator.Schema = protowidth.Schema{"tx.v1.TxOut.script_version": protowidth.Uint32}
+	err = validator.Validate(newTx(file, 1, 0, []uint32{65536}, nil, nil))
+	require.NoError(t, err)
+
+	// Without the option type, the options are not read.
+	require.NoError(t, protowidth.Validator{}.Validate(newTx(file, 1, -1, []uint32{65536}, nil, nil)))
+}
+
+// TestValidatorInvalidOption tests an option that does not name a width.
+func TestValidatorInvalidOption(t *testing.T) {
+	file, xt := withWidth(t, testFile(t), map[string]string{"Tx.lock_time": "u16"})
+
+	err := protowidth.Validator{Option: xt}.Validate(newTx(file, 1, 5, nil, nil, nil))
+	require.ErrorIs(t, err, safe.ErrInvalidSyntax)
+	assert.Equal(t, []string{"lock_time"}, fieldPaths(t, err))
+	assert.NotErrorIs(t, err, safe.ErrValueOutOfRange)
+}
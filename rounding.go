@@ -0,0 +1,200 @@
+package safeconversion
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+)
+
+// RoundingMode defines how the result of an inexact division is rounded.
+type RoundingMode uint8
+
+const (
+	// RoundDown rounds toward zero, truncating the result
+	RoundDown RoundingMode = iota
+
+	// RoundUp rounds away from zero
+	RoundUp
+
+	// RoundFloor rounds toward negative infinity
+	RoundFloor
+
+	// RoundCeiling rounds toward positive infinity
+	RoundCeiling
+
+	// RoundHalfUp rounds to the nearest value, with ties rounded away from zero
+	RoundHalfUp
+
+	// RoundHalfEven rounds to the nearest value, with ties rounded to the nearest even value
+	RoundHalfEven
+)
+
+// String returns the name of the rounding mode.
+func (m RoundingMode) String() string {
+	switch m {
+	case RoundDown:
+		return "RoundDown"
+	case RoundUp:
+		return "RoundUp"
+	case RoundFloor:
+		return "RoundFloor"
+	case RoundCeiling:
+		return "RoundCeiling"
+	case RoundHalfUp:
+		return "RoundHalfUp"
+	case RoundHalfEven:
+		return "RoundHalfEven"
+	default:
+		return fmt.Sprintf("RoundingMode(%d)", uint8(m))
+	}
+}
+
+// DivRound returns a / b rounded according to mode.
+// Returns ErrDivisionByZero if b is zero, or ErrValueOverflow if the result does not fit in T,
+// which can only happen when dividing the most negative value of a signed type by -1.
+func DivRound[T Integer](a, b T, mode RoundingMode) (T, error) {
+	if b == 0 {
+		return 0, fmt.Errorf("%w: %d / 0", ErrDivisionByZero, a)
+	}
+
+	aNeg, aMagnitude := magnitudeOf(a)
+	bNeg, bMagnitude := magnitudeOf(b)
+	neg := aNeg != bNeg
+
+	q, err := roundQuotient(aMagnitude/bMagnitude, aMagnitude%bMagnitude, bMagnitude, neg, mode)
+	if err != nil {
+		return 0, err
+	}
+
+	return fromMagnitude[T](neg, q)
+}
+
+// MulDivRound returns a * b / c rounded according to mode. The product is computed through
+// a 128-bit intermediate, so it never overflows; only the final result is range checked.
+// Returns ErrDivisionByZero if c is zero, or ErrValueOverflow if the result does not fit in T.
+func MulDivRound[T Integer](a, b, c T, mode RoundingMode) (T, error) {
+	aNeg, aMagnitude := magnitudeOf(a)
+	bNeg, bMagnitude := magnitudeOf(b)
+	cNeg, cMagnitude := magnitudeOf(c)
+	neg := aNeg != bNeg != cNeg
+
+	q, err := mulDivMagnitude(aMagnitude, bMagnitude, cMagnitude, neg, mode)
+	if err != nil {
+		return 0, err
+	}
+
+	return fromMagnitude[T](neg, q)
+}
+
+// ScaleChecked returns v * num / den truncated toward zero, such as when converting
+// nanoseconds to milliseconds or satoshis per byte to satoshis per kilobyte.
+// Returns ErrDivisionByZero if den is zero, or ErrValueOverflow if the result does not fit in T.
+func ScaleChecked[T Integer](v, num, den T) (T, error) {
+	return MulDivRound(v, num, den, RoundDown)
+}
+
+// mulDivMagnitude computes a*b/c through a 128-bit intermediate product and rounds the
+// quotient according to mode. The neg flag gives the sign of the final result, which
+// determines the direction of RoundFloor and RoundCeiling.
+// Returns an error if c is zero or the quotient does not fit in an uint64.
+func mulDivMagnitude(a, b, c uint64, neg bool, mode RoundingMode) (uint64, error) {
+	if c == 0 {
+		return 0, fmt.Errorf("%w: %d * %d / 0", ErrDivisionByZero, a, b)
+	}
+
+	hi, lo := bits.Mul64(a, b)
+	if hi >= c {
+		return 0, fmt.Errorf("%w (uint64): %d * %d / %d", ErrValueOverflow, a, b, c)
+	}
+
+	q, r := bits.Div64(hi, lo, c)
+
+	return roundQuotient(q, r, c, neg, mode)
+}
+
+// roundQuotient rounds the quotient q of a division with remainder r and divisor d.
+// Returns an error if the mode is unknown or rounding overflows an uint64.
+func roundQuotient(q, r, d uint64, neg bool, mode RoundingMode) (uint64, error) {
+	var increment bool
+
+	switch mode {
+	case RoundDown:
+	case RoundUp:
+		increment = r != 0
+	case RoundFloor:
+		increment = r != 0 && neg
+	case RoundCeiling:
+		increment = r != 0 && !neg
+	case RoundHalfUp:
+		increment = r != 0 && r >= d-r
+	case RoundHalfEven:
+		increment = r > d-r || (r != 0 && r == d-r && q&1 == 1)
+	default:
+		return 0, fmt.Errorf("%w: %s", ErrInvalidRoundingMode, mode)
+	}
+
+	if !increment {
+		return q, nil
+	}
+
+	if q == math.MaxUint64 {
+		return 0, fmt.Errorf("%w (uint64): rounding %d", ErrValueOverflow, q)
+	}
+
+	return q + 1, nil
+}
+
+// splitSign returns the sign and magnitude of v.
+func splitSign(v int64) (bool, uint64) {
+	if v < 0 {
+		return true, -uint64(v)
+	}
+
+	return false, uint64(v)
+}
+
+// joinSign combines a sign and magnitude into an int64.
+// Returns an error if the result does not fit in an int64.
+func joinSign(neg bool, magnitude uint64) (int64, error) {
+	if neg {
+		if magnitude > 1<<63 {
+			return 0, fmt.Errorf("%w (int64): -%d", ErrValueOverflow, magnitude)
+		}
+
+		return -int64(magnitude), nil //nolint:gosec // magnitude is at most 1<<63, which negates to math.MinInt64
+	}
+
+	if magnitude > math.MaxInt64 {
+		return 0, fmt.Errorf("%w (int64): %d", ErrValueOverflow, magnitude)
+	}
+
+	return int64(magnitude), nil
+}
+
+// magnitudeOf returns the sign and absolute value of v.
+func magnitudeOf[T Integer](v T) (bool, uint64) {
+	if isSigned[T]() {
+		return splitSign(int64(v))
+	}
+
+	return false, uint64(v)
+}
+
+// fromMagnitude combines a sign and absolute value into T.
+// Returns an error if the result does not fit in T.
+func fromMagnitude[T Integer](neg bool, magnitude uint64) (T, error) {
+	if !neg || magnitude == 0 {
+		if magnitude > toUint64(maxOf[T]()) {
+			return 0, fmt.Errorf("%s %w: %d", typeName[T](), ErrValueOverflow, magnitude)
+		}
+
+		return T(magnitude), nil
+	}
+
+	// The most negative value of a signed type has a magnitude one larger than its maximum.
+	if !isSigned[T]() || magnitude > toUint64(maxOf[T]())+1 {
+		return 0, fmt.Errorf("%s %w: -%d", typeName[T](), ErrValueOverflow, magnitude)
+	}
+
+	return T(-magnitude), nil
+}
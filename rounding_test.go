@@ -0,0 +1,193 @@
+package safeconversion_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	safe "github.com/bsv-blockchain/go-safe-conversion"
+)
+
+// TestRoundingModeString tests the names of the rounding modes.
+func TestRoundingModeString(t *testing.T) {
+	tests := []struct {
+		mode   safe.RoundingMode
+		expect string
+	}{
+		{safe.RoundDown, "RoundDown"},
+		{safe.RoundUp, "RoundUp"},
+		{safe.RoundFloor, "RoundFloor"},
+		{safe.RoundCeiling, "RoundCeiling"},
+		{safe.RoundHalfUp, "RoundHalfUp"},
+		{safe.RoundHalfEven, "RoundHalfEven"},
+		{safe.RoundingMode(99), "RoundingMode(99)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expect, func(t *testing.T) {
+			assert.Equal(t, tt.expect, tt.mode.String())
+		})
+	}
+}
+
+// TestDivRound tests rounding-aware integer division.
+func TestDivRound(t *testing.T) {
+	tests := []struct {
+		name    string
+		a, b    int64
+		mode    safe.RoundingMode
+		expect  int64
+		wantErr error
+	}{
+		{"exact", 10, 5, safe.RoundUp, 2, nil},
+		{"down positive", 7, 2, safe.RoundDown, 3, nil},
+		{"down negative", -7, 2, safe.RoundDown, -3, nil},
+		{"up positive", 7, 3, safe.RoundUp, 3, nil},
+		{"up negative", -7, 3, safe.RoundUp, -3, nil},
+		{"floor negative", -7, 2, safe.RoundFloor, -4, nil},
+		{"floor negative divisor", 7, -2, safe.RoundFloor, -4, nil},
+		{"ceiling positive", 7, 2, safe.RoundCeiling, 4, nil},
+		{"ceiling negative", -7, 2, safe.RoundCeiling, -3, nil},
+		{"half up tie", 5, 2, safe.RoundHalfUp, 3, nil},
+		{"half up negative tie", -5, 2, safe.RoundHalfUp, -3, nil},
+		{"half even tie down", 5, 2, safe.RoundHalfEven, 2, nil},
+		{"half even tie up", 7, 2, safe.RoundHalfEven, 4, nil},
+		{"min int64 by one", math.MinInt64, 1, safe.RoundDown, math.MinInt64, nil},
+		{"min int64 by minus one", math.MinInt64, -1, safe.RoundDown, 0, safe.ErrValueOverflow},
+		{"division by zero", 1, 0, safe.RoundDown, 0, safe.ErrDivisionByZero},
+		{"invalid rounding mode", 7, 2, safe.RoundingMode(99), 0, safe.ErrInvalidRoundingMode},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := safe.DivRound(tt.a, tt.b, tt.mode)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expect, result)
+		})
+	}
+}
+
+// TestDivRoundUnsigned tests rounding-aware division of unsigned integers.
+func TestDivRoundUnsigned(t *testing.T) {
+	kb, err := safe.DivRound[uint64](1500, 1000, safe.RoundCeiling)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), kb)
+
+	v, err := safe.DivRound[uint8](255, 2, safe.RoundHalfUp)
+	require.NoError(t, err)
+	assert.Equal(t, uint8(128), v)
+
+	v64, err := safe.DivRound[uint64](math.MaxUint64, 1, safe.RoundUp)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(math.MaxUint64), v64)
+}
+
+// TestMulDivRound tests rounding-aware multiply-then-divide.
+func TestMulDivRound(t *testing.T) {
+	tests := []struct {
+		name    string
+		a, b, c int64
+		mode    safe.RoundingMode
+		expect  int64
+		wantErr error
+	}{
+		{"sat per byte to sat per kb", 3, 1000, 1, safe.RoundDown, 3000, nil},
+		{"fee for size", 226, 500, 1000, safe.RoundCeiling, 113, nil},
+		{"rounds half even", 5, 1, 2, safe.RoundHalfEven, 2, nil},
+		{"large intermediate", math.MaxInt64, math.MaxInt64, math.MaxInt64, safe.RoundDown, math.MaxInt64, nil},
+		{"negative intermediate", math.MinInt64, math.MaxInt64, math.MaxInt64, safe.RoundDown, math.MinInt64, nil},
+		{"negative divisor", 10, 3, -4, safe.RoundFloor, -8, nil},
+		{"all negative", -10, -3, -4, safe.RoundCeiling, -7, nil},
+		{"result overflows", math.MaxInt64, 2, 1, safe.RoundDown, 0, safe.ErrValueOverflow},
+		{"division by zero", 1, 1, 0, safe.RoundDown, 0, safe.ErrDivisionByZero},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := safe.MulDivRound(tt.a, tt.b, tt.c, tt.mode)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expect, result)
+		})
+	}
+}
+
+// TestScaleChecked tests truncating scaling with overflow detection.
+func TestScaleChecked(t *testing.T) {
+	ms, err := safe.ScaleChecked[int64](1_999_999, 1, 1_000_000)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), ms)
+
+	perKB, err := safe.ScaleChecked[uint32](5, 1000, 1)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(5000), perKB)
+
+	_, err = safe.ScaleChecked[uint32](math.MaxUint32, 2, 1)
+	require.ErrorIs(t, err, safe.ErrValueOverflow)
+
+	_, err = safe.ScaleChecked[int8](-128, 1, -1)
+	require.ErrorIs(t, err, safe.ErrValueOverflow)
+
+	_, err = safe.ScaleChecked[int](1, 1, 0)
+	require.ErrorIs(t, err, safe.ErrDivisionByZero)
+}
+
+// FuzzMulDivRound validates MulDivRound against math/big for every rounding mode.
+func FuzzMulDivRound(f *testing.F) {
+	f.Add(int64(226), int64(500), int64(1000), uint8(0))
+	f.Add(int64(math.MinInt64), int64(-1), int64(1), uint8(1))
+	f.Add(int64(-7), int64(1), int64(2), uint8(5))
+	f.Fuzz(func(t *testing.T, a, b, c int64, m uint8) {
+		mode := safe.RoundingMode(m % 6)
+		result, err := safe.MulDivRound(a, b, c, mode)
+		if c == 0 {
+			require.ErrorIs(t, err, safe.ErrDivisionByZero)
+			return
+		}
+
+		num := new(big.Int).Mul(big.NewInt(a), big.NewInt(b))
+		den := big.NewInt(c)
+		q, r := new(big.Int).QuoRem(num, den, new(big.Int))
+		if r.Sign() != 0 {
+			neg := num.Sign()*den.Sign() < 0
+			twice := new(big.Int).Abs(new(big.Int).Lsh(r, 1))
+			half := twice.Cmp(new(big.Int).Abs(den))
+			var increment bool
+			switch mode {
+			case safe.RoundUp:
+				increment = true
+			case safe.RoundFloor:
+				increment = neg
+			case safe.RoundCeiling:
+				increment = !neg
+			case safe.RoundHalfUp:
+				increment = half >= 0
+			case safe.RoundHalfEven:
+				increment = half > 0 || (half == 0 && q.Bit(0) == 1)
+			case safe.RoundDown:
+			}
+			if increment {
+				q.Add(q, big.NewInt(int64(num.Sign()*den.Sign())))
+			}
+		}
+
+		if !q.IsInt64() {
+			require.ErrorIs(t, err, safe.ErrValueOverflow)
+			return
+		}
+		require.NoError(t, err)
+		assert.Equal(t, q.Int64(), result)
+	})
+}
@@ -3,12 +3,16 @@
 // when conversions fail due to invalid input or range violations.
 package safeconversion
 
+//go:generate go run ./cmd/gensafeconv
+
 import (
 	"errors"
 	"fmt"
 	"math"
 	"math/big"
+	"math/bits"
 	"time"
+	"unicode"
 )
 
 const (
@@ -18,6 +22,14 @@ const (
 	// MaxInt16 defines the maximum integer value for an int16
 	MaxInt16 = 1<<15 - 1 // 32767
 
+	// surrogateMin and surrogateMax define the UTF-16 surrogate range, which are not valid code points
+	surrogateMin = 0xD800
+	surrogateMax = 0xDFFF
+
+	// opcodeOp1 and opcodeOp1Negate define the script opcodes for OP_1 and OP_1NEGATE
+	opcodeOp1       = 0x51
+	opcodeOp1Negate = 0x4f
+
 	errFmtOutOfRangeInt32  = "%w (int32): %d"
 	errFmtOutOfRangeUint32 = "%w (uint32): %d"
 	errFmtNegToUint32      = "%w to uint32: %d"
@@ -35,13 +47,40 @@ var (
 
 	// ErrValueExceedsLimit defines when a converted value exceeds the limit of the data type
 	ErrValueExceedsLimit = errors.New("value exceeds limit")
+
+	// ErrInvalidSyntax defines when a string cannot be parsed as a number
+	ErrInvalidSyntax = errors.New("invalid syntax")
+
+	// ErrFractionalValue defines when a floating-point value with a fractional part is converted to an integer
+	ErrFractionalValue = errors.New("value has a fractional part")
+
+	// ErrUnsupportedType defines when a value of an unsupported type is used as a conversion source
+	ErrUnsupportedType = errors.New("unsupported type")
+
+	// ErrDivisionByZero defines when a division has a zero divisor
+	ErrDivisionByZero = errors.New("division by zero")
+
+	// ErrInvalidRoundingMode defines when an unknown rounding mode is used
+	ErrInvalidRoundingMode = errors.New("invalid rounding mode")
+
+	// ErrInvalidRune defines when a value is not a valid Unicode code point
+	ErrInvalidRune = errors.New("invalid rune")
+
+	// ErrNonRealValue defines when a complex value with a non-zero imaginary part is converted to a real type
+	ErrNonRealValue = errors.New("value has a non-zero imaginary part")
+
+	// ErrInexactValue defines when a value cannot be represented exactly in the destination type
+	ErrInexactValue = errors.New("value cannot be represented exactly")
+
+	// ErrValueUnderflow defines when a non-zero value is too small to be represented with full precision
+	ErrValueUnderflow = errors.New("value underflow")
 )
 
 // IntToUint32 converts an int to uint32 after ensuring it’s in range.
 // Returns an error if the input is negative or exceeds the maximum value of an uint32.
 func IntToUint32(v int) (uint32, error) {
 	if v < 0 || v > math.MaxUint32 {
-		return 0, fmt.Errorf("%w: %d", ErrValueOutOfRange, v)
+		return 0, failure("int", "uint32", v, fmt.Errorf("%w: %d", ErrValueOutOfRange, v))
 	}
 
 	return uint32(v), nil
@@ -52,7 +91,7 @@ func IntToUint32(v int) (uint32, error) {
 func Uint64ToUint32(v uint64) (uint32, error) {
 	// ^uint32(0) is the maximum value of an uint32 (all-bits set).
 	if v > uint64(math.MaxUint32) {
-		return 0, fmt.Errorf("uint32 %w: %d (max %d)", ErrValueOverflow, v, math.MaxUint32)
+		return 0, failure("uint64", "uint32", v, fmt.Errorf("uint32 %w: %d (max %d)", ErrValueOverflow, v, math.MaxUint32))
 	}
 
 	return uint32(v), nil
@@ -62,7 +101,7 @@ func Uint64ToUint32(v uint64) (uint32, error) {
 // Returns an error if the input is negative.
 func Int64ToUint64(value int64) (uint64, error) {
 	if value < 0 {
-		return 0, fmt.Errorf("%w (uint64): %d", ErrNegativeValueCannotBeConverted, value)
+		return 0, failure("int64", "uint64", value, fmt.Errorf("%w (uint64): %d", ErrNegativeValueCannotBeConverted, value))
 	}
 
 	return uint64(value), nil
@@ -72,7 +111,7 @@ func Int64ToUint64(value int64) (uint64, error) {
 // Returns an error if the input is negative.
 func IntToUint64(value int) (uint64, error) {
 	if value < 0 {
-		return 0, fmt.Errorf("%w (uint64): %d", ErrNegativeValueCannotBeConverted, value)
+		return 0, failure("int", "uint64", value, fmt.Errorf("%w (uint64): %d", ErrNegativeValueCannotBeConverted, value))
 	}
 
 	return uint64(value), nil
@@ -82,7 +121,7 @@ func IntToUint64(value int) (uint64, error) {
 // Returns an error if the value exceeds the limits of an int.
 func Uint64ToInt(value uint64) (int, error) {
 	if value > math.MaxInt {
-		return 0, fmt.Errorf("%w (int): %d", ErrValueExceedsLimit, value)
+		return 0, failure("uint64", "int", value, fmt.Errorf("%w (int): %d", ErrValueExceedsLimit, value))
 	}
 
 	return int(value), nil
@@ -92,7 +131,7 @@ func Uint64ToInt(value uint64) (int, error) {
 // Returns an error if the value is outside the range of int32.
 func Int64ToInt32(value int64) (int32, error) {
 	if value < math.MinInt32 || value > math.MaxInt32 {
-		return 0, fmt.Errorf(errFmtOutOfRangeInt32, ErrValueOutOfRange, value)
+		return 0, failure("int64", "int32", value, fmt.Errorf(errFmtOutOfRangeInt32, ErrValueOutOfRange, value))
 	}
 
 	return int32(value), nil
@@ -102,7 +141,7 @@ func Int64ToInt32(value int64) (int32, error) {
 // Checks if the value is within the valid int32 range.
 func IntToInt32(value int) (int32, error) {
 	if value < math.MinInt32 || value > math.MaxInt32 {
-		return 0, fmt.Errorf(errFmtOutOfRangeInt32, ErrValueOutOfRange, value)
+		return 0, failure("int", "int32", value, fmt.Errorf(errFmtOutOfRangeInt32, ErrValueOutOfRange, value))
 	}
 
 	return int32(value), nil
@@ -112,7 +151,7 @@ func IntToInt32(value int) (int32, error) {
 // Checks only for negative values, as positive int32 values are always within the uint32 range.
 func Int32ToUint32(value int32) (uint32, error) {
 	if value < 0 {
-		return 0, fmt.Errorf(errFmtNegToUint32, ErrNegativeValueCannotBeConverted, value)
+		return 0, failure("int32", "uint32", value, fmt.Errorf(errFmtNegToUint32, ErrNegativeValueCannotBeConverted, value))
 	}
 
 	return uint32(value), nil
@@ -122,11 +161,11 @@ func Int32ToUint32(value int32) (uint32, error) {
 // Checks if the value is non-negative and within the uint32 range.
 func Int64ToUint32(value int64) (uint32, error) {
 	if value < 0 {
-		return 0, fmt.Errorf(errFmtNegToUint32, ErrNegativeValueCannotBeConverted, value)
+		return 0, failure("int64", "uint32", value, fmt.Errorf(errFmtNegToUint32, ErrNegativeValueCannotBeConverted, value))
 	}
 
 	if value > math.MaxUint32 {
-		return 0, fmt.Errorf(errFmtOutOfRangeUint32, ErrValueOutOfRange, value)
+		return 0, failure("int64", "uint32", value, fmt.Errorf(errFmtOutOfRangeUint32, ErrValueOutOfRange, value))
 	}
 
 	return uint32(value), nil
@@ -144,7 +183,7 @@ func BigWordToUint32(value big.Word) (uint32, error) {
 	valueUint64 := uint64(value)
 
 	if valueUint64 > math.MaxUint32 {
-		return 0, fmt.Errorf("big.Word %w (uint32): %d", ErrValueExceedsLimit, valueUint64)
+		return 0, failure("big.Word", "uint32", value, fmt.Errorf("big.Word %w (uint32): %d", ErrValueExceedsLimit, valueUint64))
 	}
 
 	return uint32(valueUint64), nil
@@ -154,11 +193,11 @@ func BigWordToUint32(value big.Word) (uint32, error) {
 // Checks if the value is non-negative and within the uint16 range.
 func IntToUint16(value int) (uint16, error) {
 	if value < 0 {
-		return 0, fmt.Errorf("%w to uint16: %d", ErrNegativeValueCannotBeConverted, value)
+		return 0, failure("int", "uint16", value, fmt.Errorf("%w to uint16: %d", ErrNegativeValueCannotBeConverted, value))
 	}
 
 	if value > math.MaxUint16 {
-		return 0, fmt.Errorf("%w (uint16): %d", ErrValueExceedsLimit, value)
+		return 0, failure("int", "uint16", value, fmt.Errorf("%w (uint16): %d", ErrValueExceedsLimit, value))
 	}
 
 	return uint16(value), nil
@@ -168,7 +207,7 @@ func IntToUint16(value int) (uint16, error) {
 // Checks if the value is within the valid int16 range.
 func IntToInt16(value int) (int16, error) {
 	if value < MinInt16 || value > MaxInt16 {
-		return 0, fmt.Errorf("%w (int16): %d", ErrValueOutOfRange, value)
+		return 0, failure("int", "int16", value, fmt.Errorf("%w (int16): %d", ErrValueOutOfRange, value))
 	}
 
 	return int16(value), nil
@@ -178,7 +217,7 @@ func IntToInt16(value int) (int16, error) {
 // Checks if the value exceeds the uint32 range.
 func UintToUint32(value uint) (uint32, error) {
 	if value > math.MaxUint32 {
-		return 0, fmt.Errorf(errFmtOutOfRangeUint32, ErrValueOutOfRange, value)
+		return 0, failure("uint", "uint32", value, fmt.Errorf(errFmtOutOfRangeUint32, ErrValueOutOfRange, value))
 	}
 
 	return uint32(value), nil
@@ -189,11 +228,11 @@ func UintToUint32(value uint) (uint32, error) {
 func TimeToUint32(value time.Time) (uint32, error) {
 	timestamp := value.Unix()
 	if timestamp < 0 {
-		return 0, fmt.Errorf(errFmtNegToUint32, ErrNegativeValueCannotBeConverted, timestamp)
+		return 0, failure("time.Time", "uint32", value, fmt.Errorf(errFmtNegToUint32, ErrNegativeValueCannotBeConverted, timestamp))
 	}
 
 	if timestamp > math.MaxUint32 {
-		return 0, fmt.Errorf(errFmtOutOfRangeUint32, ErrValueOutOfRange, timestamp)
+		return 0, failure("time.Time", "uint32", value, fmt.Errorf(errFmtOutOfRangeUint32, ErrValueOutOfRange, timestamp))
 	}
 
 	return uint32(timestamp), nil
@@ -203,7 +242,7 @@ func TimeToUint32(value time.Time) (uint32, error) {
 // Checks if the value exceeds the uint8 range.
 func Uint32ToUint8(value uint32) (uint8, error) {
 	if value > math.MaxUint8 {
-		return 0, fmt.Errorf("%w (uint8): %d", ErrValueOutOfRange, value)
+		return 0, failure("uint32", "uint8", value, fmt.Errorf("%w (uint8): %d", ErrValueOutOfRange, value))
 	}
 
 	return uint8(value), nil
@@ -213,17 +252,73 @@ func Uint32ToUint8(value uint32) (uint8, error) {
 // Checks if the value exceeds the maximum int range.
 func UintptrToInt(value uintptr) (int, error) {
 	if value > uintptr(math.MaxInt) {
-		return 0, fmt.Errorf("%w (int): %d", ErrValueOutOfRange, value)
+		return 0, failure("uintptr", "int", value, fmt.Errorf("%w (int): %d", ErrValueOutOfRange, value))
 	}
 
 	return int(value), nil
 }
 
+// IntToUintptr safely converts an int to uintptr.
+// Returns an error if the input is negative.
+func IntToUintptr(value int) (uintptr, error) {
+	if value < 0 {
+		return 0, failure("int", "uintptr", value, fmt.Errorf("%w to uintptr: %d", ErrNegativeValueCannotBeConverted, value))
+	}
+
+	return uintptr(value), nil
+}
+
+// Int64ToUintptr safely converts an int64 to uintptr.
+// Checks if the value is non-negative and within the uintptr range, which is 32 bits on 32-bit platforms.
+func Int64ToUintptr(value int64) (uintptr, error) {
+	if value < 0 {
+		return 0, failure("int64", "uintptr", value, fmt.Errorf("%w to uintptr: %d", ErrNegativeValueCannotBeConverted, value))
+	}
+
+	if uint64(value) > uint64(^uintptr(0)) {
+		return 0, failure("int64", "uintptr", value, fmt.Errorf("%w (uintptr): %d", ErrValueOutOfRange, value))
+	}
+
+	return uintptr(value), nil
+}
+
+// Uint64ToUintptr safely converts an uint64 to uintptr.
+// Checks if the value exceeds the uintptr range, which is 32 bits on 32-bit platforms.
+func Uint64ToUintptr(value uint64) (uintptr, error) {
+	if value > uint64(^uintptr(0)) {
+		return 0, failure("uint64", "uintptr", value, fmt.Errorf("%w (uintptr): %d", ErrValueOutOfRange, value))
+	}
+
+	return uintptr(value), nil
+}
+
+// CheckedOffset safely computes base + index*elemSize, as used when addressing elements of
+// memory-mapped storage. Returns an error if the multiplication overflows, the offset does
+// not fit in an uintptr, or adding it to base wraps around the address space.
+func CheckedOffset(base uintptr, index, elemSize uint64) (uintptr, error) {
+	hi, product := bits.Mul64(index, elemSize)
+	if hi != 0 {
+		return 0, failure("uint64", "uintptr", index, fmt.Errorf("offset %w: %d * %d", ErrValueOverflow, index, elemSize))
+	}
+
+	if product > uint64(^uintptr(0)) {
+		return 0, failure("uint64", "uintptr", index, fmt.Errorf("offset %w: %d * %d", ErrValueOverflow, index, elemSize))
+	}
+
+	offset := uintptr(product)
+	address := base + offset
+	if address < base {
+		return 0, failure("uint64", "uintptr", index, fmt.Errorf("offset %w: %#x + %d", ErrValueOverflow, base, offset))
+	}
+
+	return address, nil
+}
+
 // Uint64ToInt64 safely converts an uint64 to int64.
 // Checks if the value exceeds the maximum int64 range.
 func Uint64ToInt64(value uint64) (int64, error) {
 	if value > math.MaxInt64 {
-		return 0, fmt.Errorf("%w (int64): %d", ErrValueOutOfRange, value)
+		return 0, failure("uint64", "int64", value, fmt.Errorf("%w (int64): %d", ErrValueOutOfRange, value))
 	}
 
 	return int64(value), nil
@@ -233,7 +328,7 @@ func Uint64ToInt64(value uint64) (int64, error) {
 // Checks if the value exceeds the maximum int32 range.
 func Uint32ToInt32(value uint32) (int32, error) {
 	if value > math.MaxInt32 {
-		return 0, fmt.Errorf(errFmtOutOfRangeInt32, ErrValueOutOfRange, value)
+		return 0, failure("uint32", "int32", value, fmt.Errorf(errFmtOutOfRangeInt32, ErrValueOutOfRange, value))
 	}
 
 	return int32(value), nil
@@ -243,7 +338,7 @@ func Uint32ToInt32(value uint32) (int32, error) {
 // Checks if the value exceeds the int32 range or if it's negative.
 func Uint64ToInt32(value uint64) (int32, error) {
 	if value > math.MaxInt32 {
-		return 0, fmt.Errorf(errFmtOutOfRangeInt32, ErrValueOutOfRange, value)
+		return 0, failure("uint64", "int32", value, fmt.Errorf(errFmtOutOfRangeInt32, ErrValueOutOfRange, value))
 	}
 
 	return int32(value), nil
@@ -265,8 +360,67 @@ func Uint32ToUint64(value uint32) (uint64, error) {
 // Checks if the value exceeds the uint16 range.
 func Uint64ToUint16(value uint64) (uint16, error) {
 	if value > math.MaxUint16 {
-		return 0, fmt.Errorf("%w (uint16): %d", ErrValueOutOfRange, value)
+		return 0, failure("uint64", "uint16", value, fmt.Errorf("%w (uint16): %d", ErrValueOutOfRange, value))
 	}
 
 	return uint16(value), nil
 }
+
+// IntToByte safely converts an int to byte.
+// Checks if the value is non-negative and within the byte range.
+func IntToByte(value int) (byte, error) {
+	if value < 0 {
+		return 0, failure("int", "byte", value, fmt.Errorf("%w to byte: %d", ErrNegativeValueCannotBeConverted, value))
+	}
+
+	if value > math.MaxUint8 {
+		return 0, failure("int", "byte", value, fmt.Errorf("%w (byte): %d", ErrValueOutOfRange, value))
+	}
+
+	return byte(value), nil
+}
+
+// IntToRune safely converts an int to a rune.
+// Checks if the value is a valid Unicode code point: non-negative, at most unicode.MaxRune,
+// and not a UTF-16 surrogate.
+func IntToRune(value int) (rune, error) {
+	if value < 0 || value > unicode.MaxRune {
+		return 0, failure("int", "rune", value, fmt.Errorf("%w: %w (rune): %d", ErrInvalidRune, ErrValueOutOfRange, value))
+	}
+
+	if value >= surrogateMin && value <= surrogateMax {
+		return 0, failure("int", "rune", value, fmt.Errorf("%w: surrogate %#x", ErrInvalidRune, value))
+	}
+
+	return rune(value), nil
+}
+
+// Uint32ToRune safely converts an uint32 to a rune.
+// Checks if the value is a valid Unicode code point: at most unicode.MaxRune and not a UTF-16 surrogate.
+func Uint32ToRune(value uint32) (rune, error) {
+	if value > unicode.MaxRune {
+		return 0, failure("uint32", "rune", value, fmt.Errorf("%w: %w (rune): %d", ErrInvalidRune, ErrValueOutOfRange, value))
+	}
+
+	if value >= surrogateMin && value <= surrogateMax {
+		return 0, failure("uint32", "rune", value, fmt.Errorf("%w: surrogate %#x", ErrInvalidRune, value))
+	}
+
+	return rune(value), nil
+}
+
+// OpcodeFromInt safely converts a small integer to the script opcode that pushes it:
+// OP_0 for 0, OP_1 through OP_16 for 1 through 16, and OP_1NEGATE for -1.
+// Returns an error if the value has no small integer opcode.
+func OpcodeFromInt(value int) (byte, error) {
+	switch {
+	case value == 0:
+		return 0, nil
+	case value == -1:
+		return opcodeOp1Negate, nil
+	case value >= 1 && value <= 16:
+		return byte(opcodeOp1 + value - 1), nil
+	default:
+		return 0, failure("int", "opcode", value, fmt.Errorf("%w (opcode): %d", ErrValueOutOfRange, value))
+	}
+}
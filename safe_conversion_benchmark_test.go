@@ -23,6 +23,21 @@ func BenchmarkBigWordToUint32(b *testing.B) {
 	_ = r
 }
 
+// BenchmarkCheckedOffset benchmarks the performance of CheckedOffset.
+func BenchmarkCheckedOffset(b *testing.B) {
+	var r uintptr
+	var err error
+	const base uintptr = 0x1000
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.CheckedOffset(base, 10, 32)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
 // BenchmarkInt32ToUint32 benchmarks the performance of Int32ToUint32.
 func BenchmarkInt32ToUint32(b *testing.B) {
 	var r uint32
@@ -83,6 +98,36 @@ func BenchmarkInt64ToUint64(b *testing.B) {
 	_ = r
 }
 
+// BenchmarkInt64ToUintptr benchmarks the performance of Int64ToUintptr.
+func BenchmarkInt64ToUintptr(b *testing.B) {
+	var r uintptr
+	var err error
+	const v int64 = 100
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Int64ToUintptr(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkIntToByte benchmarks the performance of IntToByte.
+func BenchmarkIntToByte(b *testing.B) {
+	var r byte
+	var err error
+	v := 100
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.IntToByte(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
 // BenchmarkIntToInt16 benchmarks the performance of IntToInt16.
 func BenchmarkIntToInt16(b *testing.B) {
 	var r int16
@@ -114,6 +159,21 @@ func BenchmarkIntToInt32(b *testing.B) {
 	_ = r
 }
 
+// BenchmarkIntToRune benchmarks the performance of IntToRune.
+func BenchmarkIntToRune(b *testing.B) {
+	var r rune
+	var err error
+	v := 0x41
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.IntToRune(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
 // BenchmarkIntToUint16 benchmarks the performance of IntToUint16.
 func BenchmarkIntToUint16(b *testing.B) {
 	var r uint16
@@ -159,6 +219,36 @@ func BenchmarkIntToUint64(b *testing.B) {
 	_ = r
 }
 
+// BenchmarkIntToUintptr benchmarks the performance of IntToUintptr.
+func BenchmarkIntToUintptr(b *testing.B) {
+	var r uintptr
+	var err error
+	const v int = 100
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.IntToUintptr(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
+// BenchmarkOpcodeFromInt benchmarks the performance of OpcodeFromInt.
+func BenchmarkOpcodeFromInt(b *testing.B) {
+	var r byte
+	var err error
+	v := 16
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.OpcodeFromInt(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
 // BenchmarkTimeToUint32 benchmarks the performance of TimeToUint32.
 func BenchmarkTimeToUint32(b *testing.B) {
 	var r uint32
@@ -200,6 +290,21 @@ func BenchmarkUint32ToInt64(b *testing.B) {
 	_ = r
 }
 
+// BenchmarkUint32ToRune benchmarks the performance of Uint32ToRune.
+func BenchmarkUint32ToRune(b *testing.B) {
+	var r rune
+	var err error
+	v := uint32(0x41)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Uint32ToRune(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
 // BenchmarkUint32ToUint64 benchmarks the performance of Uint32ToUint64.
 func BenchmarkUint32ToUint64(b *testing.B) {
 	var r uint64
@@ -301,6 +406,21 @@ func BenchmarkUint64ToUint32(b *testing.B) {
 	_ = r
 }
 
+// BenchmarkUint64ToUintptr benchmarks the performance of Uint64ToUintptr.
+func BenchmarkUint64ToUintptr(b *testing.B) {
+	var r uintptr
+	var err error
+	const v uint64 = 100
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, err = safe.Uint64ToUintptr(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = r
+}
+
 // BenchmarkUintptrToInt benchmarks the performance of UintptrToInt.
 func BenchmarkUintptrToInt(b *testing.B) {
 	var r int
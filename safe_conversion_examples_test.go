@@ -1,6 +1,7 @@
 package safeconversion
 
 import (
+	"encoding/binary"
 	"fmt"
 	"math/big"
 	"time"
@@ -184,6 +185,50 @@ func ExampleUintptrToInt() {
 	// Output: 42
 }
 
+// ExampleIntToUintptr demonstrates converting an int to a uintptr.
+func ExampleIntToUintptr() {
+	v, err := IntToUintptr(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleInt64ToUintptr demonstrates converting an int64 to a uintptr.
+func ExampleInt64ToUintptr() {
+	v, err := Int64ToUintptr(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleUint64ToUintptr demonstrates converting a uint64 to a uintptr.
+func ExampleUint64ToUintptr() {
+	v, err := Uint64ToUintptr(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleCheckedOffset demonstrates computing the address of an element in a memory-mapped region.
+func ExampleCheckedOffset() {
+	v, err := CheckedOffset(0x1000, 10, 32)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Printf("%#x\n", v)
+	// Output: 0x1140
+}
+
 // ExampleUint64ToInt64 demonstrates converting a uint64 to an int64.
 func ExampleUint64ToInt64() {
 	v, err := Uint64ToInt64(42)
@@ -241,3 +286,128 @@ func ExampleUint64ToUint16() {
 	fmt.Println(v)
 	// Output: 42
 }
+
+// ExampleIntToByte demonstrates converting an int to a byte.
+func ExampleIntToByte() {
+	v, err := IntToByte(42)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 42
+}
+
+// ExampleIntToRune demonstrates converting an int to a rune.
+func ExampleIntToRune() {
+	v, err := IntToRune(0x41)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(string(v))
+	// Output: A
+}
+
+// ExampleUint32ToRune demonstrates converting a uint32 to a rune.
+func ExampleUint32ToRune() {
+	v, err := Uint32ToRune(0x41)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(string(v))
+	// Output: A
+}
+
+// ExampleOpcodeFromInt demonstrates converting a small integer to its push opcode.
+func ExampleOpcodeFromInt() {
+	v, err := OpcodeFromInt(16)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Printf("%#x\n", v)
+	// Output: 0x60
+}
+
+// ExampleComplex128ToFloat64 demonstrates taking the real part of a complex128.
+func ExampleComplex128ToFloat64() {
+	v, err := Complex128ToFloat64(complex(1.5, 0))
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 1.5
+}
+
+// ExampleComplexTo demonstrates rejecting a complex value with an imaginary part.
+func ExampleComplexTo() {
+	_, err := ComplexTo[int64](complex(42, 0.5))
+	fmt.Println(errorPrefix, err)
+	// Output: error: value has a non-zero imaginary part (int64): (42+0.5i)
+}
+
+// ExampleComplex128ToComplex64 demonstrates detecting precision loss when narrowing a complex128.
+func ExampleComplex128ToComplex64() {
+	_, err := Complex128ToComplex64(complex(0.1, 0))
+	fmt.Println(errorPrefix, err)
+	// Output: error: value cannot be represented exactly (complex64): (0.1+0i)
+}
+
+// ExampleFloat64ToFloat32 demonstrates detecting overflow when narrowing a float64.
+func ExampleFloat64ToFloat32() {
+	_, err := Float64ToFloat32(1e39)
+	fmt.Println(errorPrefix, err)
+	// Output: error: float32 value overflow: 1e+39
+}
+
+// ExampleFloat64ToFloat32Round demonstrates narrowing a float64 with an explicit rounding mode.
+func ExampleFloat64ToFloat32Round() {
+	v, err := Float64ToFloat32Round(0.1, RoundDown)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(float64(v) < 0.1)
+	// Output: true
+}
+
+// ExampleBytesToUint64 demonstrates decoding a variable-length little-endian integer.
+func ExampleBytesToUint64() {
+	v, err := BytesToUint64([]byte{0xfd, 0x00, 0x01}, binary.LittleEndian)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: 65789
+}
+
+// ExampleBytesToInt64Signed demonstrates decoding a sign-magnitude integer.
+func ExampleBytesToInt64Signed() {
+	v, err := BytesToInt64Signed([]byte{0x81, 0x00}, binary.BigEndian, SignMagnitude)
+	if err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+	fmt.Println(v)
+	// Output: -256
+}
+
+// ExampleCheckedCounter_Add demonstrates a counter refusing to wrap around.
+func ExampleCheckedCounter_Add() {
+	var txCount CheckedCounter[uint8]
+	if err := txCount.Store(250); err != nil {
+		fmt.Println(errorPrefix, err)
+		return
+	}
+
+	_, err := txCount.Add(10)
+	fmt.Println(errorPrefix, err)
+	fmt.Println(txCount.Load())
+	// Output:
+	// error: uint8 counter value overflow: 250 + 10
+	// 250
+}
@@ -5,6 +5,8 @@ import (
 	"math/big"
 	"testing"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -263,8 +265,74 @@ func FuzzUintptrToInt(f *testing.F) {
 	})
 }
 
-// FuzzConvertUint64ToInt64 validates Uint64ToInt64 with random inputs.
-func FuzzConvertUint64ToInt64(f *testing.F) {
+// FuzzIntToUintptr validates IntToUintptr with random inputs.
+func FuzzIntToUintptr(f *testing.F) {
+	f.Add(0)
+	f.Add(-1)
+	f.Add(math.MaxInt)
+	f.Fuzz(func(t *testing.T, v int) {
+		r, err := safe.IntToUintptr(v)
+		if v < 0 {
+			require.Error(t, err)
+			return
+		}
+		require.NoError(t, err)
+		assert.Equal(t, uintptr(v), r)
+	})
+}
+
+// FuzzInt64ToUintptr validates Int64ToUintptr with random inputs.
+func FuzzInt64ToUintptr(f *testing.F) {
+	f.Add(int64(0))
+	f.Add(int64(-1))
+	f.Add(int64(math.MaxInt64))
+	f.Fuzz(func(t *testing.T, v int64) {
+		r, err := safe.Int64ToUintptr(v)
+		if v < 0 || uint64(v) > uint64(^uintptr(0)) {
+			require.Error(t, err)
+			return
+		}
+		require.NoError(t, err)
+		assert.Equal(t, uintptr(v), r)
+	})
+}
+
+// FuzzUint64ToUintptr validates Uint64ToUintptr with random inputs.
+func FuzzUint64ToUintptr(f *testing.F) {
+	f.Add(uint64(0))
+	f.Add(uint64(math.MaxUint32))
+	f.Add(uint64(math.MaxUint64))
+	f.Fuzz(func(t *testing.T, v uint64) {
+		r, err := safe.Uint64ToUintptr(v)
+		if v > uint64(^uintptr(0)) {
+			require.Error(t, err)
+			return
+		}
+		require.NoError(t, err)
+		assert.Equal(t, uintptr(v), r)
+	})
+}
+
+// FuzzCheckedOffset validates CheckedOffset with random inputs.
+func FuzzCheckedOffset(f *testing.F) {
+	f.Add(uint64(0), uint64(0), uint64(0))
+	f.Add(uint64(0x1000), uint64(10), uint64(32))
+	f.Add(uint64(math.MaxUint64), uint64(1), uint64(1))
+	f.Fuzz(func(t *testing.T, base, index, elemSize uint64) {
+		r, err := safe.CheckedOffset(uintptr(base), index, elemSize)
+		expect := new(big.Int).Mul(new(big.Int).SetUint64(index), new(big.Int).SetUint64(elemSize))
+		expect.Add(expect, new(big.Int).SetUint64(uint64(uintptr(base))))
+		if expect.Cmp(new(big.Int).SetUint64(uint64(^uintptr(0)))) > 0 {
+			require.Error(t, err)
+			return
+		}
+		require.NoError(t, err)
+		assert.Equal(t, expect.Uint64(), uint64(r))
+	})
+}
+
+// FuzzUint64ToInt64 validates Uint64ToInt64 with random inputs.
+func FuzzUint64ToInt64(f *testing.F) {
 	f.Add(uint64(0))
 	f.Add(uint64(math.MaxInt64))
 	f.Fuzz(func(t *testing.T, v uint64) {
@@ -278,8 +346,8 @@ func FuzzConvertUint64ToInt64(f *testing.F) {
 	})
 }
 
-// FuzzConvertUint32ToInt32 validates Uint32ToInt32 with random inputs.
-func FuzzConvertUint32ToInt32(f *testing.F) {
+// FuzzUint32ToInt32 validates Uint32ToInt32 with random inputs.
+func FuzzUint32ToInt32(f *testing.F) {
 	f.Add(uint32(0))
 	f.Add(uint32(math.MaxInt32))
 	f.Fuzz(func(t *testing.T, v uint32) {
@@ -293,8 +361,8 @@ func FuzzConvertUint32ToInt32(f *testing.F) {
 	})
 }
 
-// FuzzConvertUint64ToInt32 validates Uint64ToInt32 with random inputs.
-func FuzzConvertUint64ToInt32(f *testing.F) {
+// FuzzUint64ToInt32 validates Uint64ToInt32 with random inputs.
+func FuzzUint64ToInt32(f *testing.F) {
 	f.Add(uint64(0))
 	f.Add(uint64(math.MaxInt32))
 	f.Fuzz(func(t *testing.T, v uint64) {
@@ -344,3 +412,74 @@ func FuzzUint64ToUint16(f *testing.F) {
 		assert.Equal(t, uint16(v), r)
 	})
 }
+
+// FuzzIntToByte validates IntToByte with random inputs.
+func FuzzIntToByte(f *testing.F) {
+	f.Add(0)
+	f.Add(-1)
+	f.Add(math.MaxUint8 + 1)
+	f.Fuzz(func(t *testing.T, v int) {
+		r, err := safe.IntToByte(v)
+		if v < 0 || v > math.MaxUint8 {
+			require.Error(t, err)
+			return
+		}
+		require.NoError(t, err)
+		assert.Equal(t, byte(v), r)
+	})
+}
+
+// FuzzIntToRune validates IntToRune with random inputs.
+func FuzzIntToRune(f *testing.F) {
+	f.Add(0)
+	f.Add(0xD800)
+	f.Add(int(unicode.MaxRune) + 1)
+	f.Fuzz(func(t *testing.T, v int) {
+		r, err := safe.IntToRune(v)
+		if v < 0 || v > unicode.MaxRune || !utf8.ValidRune(rune(v)) {
+			require.ErrorIs(t, err, safe.ErrInvalidRune)
+			return
+		}
+		require.NoError(t, err)
+		assert.Equal(t, rune(v), r)
+	})
+}
+
+// FuzzUint32ToRune validates Uint32ToRune with random inputs.
+func FuzzUint32ToRune(f *testing.F) {
+	f.Add(uint32(0))
+	f.Add(uint32(0xDFFF))
+	f.Add(uint32(math.MaxUint32))
+	f.Fuzz(func(t *testing.T, v uint32) {
+		r, err := safe.Uint32ToRune(v)
+		if v > unicode.MaxRune || !utf8.ValidRune(rune(v)) {
+			require.ErrorIs(t, err, safe.ErrInvalidRune)
+			return
+		}
+		require.NoError(t, err)
+		assert.Equal(t, rune(v), r)
+	})
+}
+
+// FuzzOpcodeFromInt validates OpcodeFromInt with random inputs.
+func FuzzOpcodeFromInt(f *testing.F) {
+	f.Add(0)
+	f.Add(-1)
+	f.Add(17)
+	f.Fuzz(func(t *testing.T, v int) {
+		r, err := safe.OpcodeFromInt(v)
+		if v < -1 || v > 16 {
+			require.Error(t, err)
+			return
+		}
+		require.NoError(t, err)
+		switch v {
+		case 0:
+			assert.Equal(t, byte(0x00), r)
+		case -1:
+			assert.Equal(t, byte(0x4f), r)
+		default:
+			assert.Equal(t, byte(0x50+v), r)
+		}
+	})
+}
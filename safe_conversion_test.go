@@ -4,8 +4,10 @@ package safeconversion_test
 import (
 	"math"
 	"math/big"
+	"strconv"
 	"testing"
 	"time"
+	"unicode"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -497,6 +499,129 @@ func TestUintptrToInt(t *testing.T) {
 	}
 }
 
+// TestIntToUintptr tests the conversion from int to uintptr.
+func TestIntToUintptr(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   int
+		expect  uintptr
+		wantErr bool
+	}{
+		{zeroValueName, 0, 0, false},
+		{positiveValueName, 100, 100, false},
+		{maxIntName, math.MaxInt, uintptr(math.MaxInt), false},
+		{negativeValueName, -1, 0, true},
+		{minInt64Name, math.MinInt64, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := safe.IntToUintptr(tt.input)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expect, result)
+		})
+	}
+}
+
+// TestInt64ToUintptr tests the conversion from int64 to uintptr.
+func TestInt64ToUintptr(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   int64
+		expect  uintptr
+		wantErr bool
+	}{
+		{zeroValueName, 0, 0, false},
+		{positiveValueName, 100, 100, false},
+		{maxUint32Name, math.MaxUint32, math.MaxUint32, false},
+		{negativeValueName, -1, 0, true},
+		{minInt64Name, math.MinInt64, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := safe.Int64ToUintptr(tt.input)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expect, result)
+		})
+	}
+}
+
+// TestUint64ToUintptr tests the conversion from uint64 to uintptr.
+func TestUint64ToUintptr(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   uint64
+		expect  uintptr
+		wantErr bool
+	}{
+		{zeroValueName, 0, 0, false},
+		{positiveValueName, 100, 100, false},
+		{maxUint32Name, math.MaxUint32, math.MaxUint32, false},
+		{"max uintptr", uint64(^uintptr(0)), ^uintptr(0), false},
+		{maxUint64Name, math.MaxUint64, ^uintptr(0), strconv.IntSize == 32}, // Only out of range on 32-bit systems
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := safe.Uint64ToUintptr(tt.input)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expect, result)
+		})
+	}
+}
+
+// TestCheckedOffset tests computing checked pointer offsets.
+func TestCheckedOffset(t *testing.T) {
+	maxUintptr := ^uintptr(0)
+
+	tests := []struct {
+		name     string
+		base     uintptr
+		index    uint64
+		elemSize uint64
+		expect   uintptr
+		wantErr  error
+	}{
+		{zeroValueName, 0, 0, 0, 0, nil},
+		{"first element", 0x1000, 0, 32, 0x1000, nil},
+		{"tenth element", 0x1000, 10, 32, 0x1000 + 320, nil},
+		{"end of address space", maxUintptr - 31, 1, 31, maxUintptr, nil},
+		{"address wraps around", maxUintptr - 31, 1, 32, 0, safe.ErrValueOverflow},
+		{"base wraps around", maxUintptr, 1, 1, 0, safe.ErrValueOverflow},
+		{"multiplication overflows", 0, math.MaxUint64, 2, 0, safe.ErrValueOverflow},
+		{"offset spans address space", 0, uint64(maxUintptr), 1, maxUintptr, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := safe.CheckedOffset(tt.base, tt.index, tt.elemSize)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expect, result)
+		})
+	}
+}
+
 // TestUint64ToInt64 tests the conversion from uint64 to int64.
 func TestUint64ToInt64(t *testing.T) {
 	tests := []struct {
@@ -666,3 +791,128 @@ func TestUint64ToUint16(t *testing.T) {
 		})
 	}
 }
+
+// TestIntToByte tests the conversion from int to byte.
+func TestIntToByte(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   int
+		expect  byte
+		wantErr bool
+	}{
+		{zeroValueName, 0, 0, false},
+		{positiveValueName, 100, 100, false},
+		{"max byte", math.MaxUint8, math.MaxUint8, false},
+		{negativeValueName, -1, 0, true},
+		{valueTooLargeName, math.MaxUint8 + 1, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := safe.IntToByte(tt.input)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expect, result)
+		})
+	}
+}
+
+// TestIntToRune tests the conversion from int to rune.
+func TestIntToRune(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   int
+		expect  rune
+		wantErr bool
+	}{
+		{zeroValueName, 0, 0, false},
+		{"ascii", 'A', 'A', false},
+		{"before surrogates", 0xD7FF, 0xD7FF, false},
+		{"after surrogates", 0xE000, 0xE000, false},
+		{"max rune", unicode.MaxRune, unicode.MaxRune, false},
+		{"first surrogate", 0xD800, 0, true},
+		{"last surrogate", 0xDFFF, 0, true},
+		{negativeValueName, -1, 0, true},
+		{valueTooLargeName, unicode.MaxRune + 1, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := safe.IntToRune(tt.input)
+			if tt.wantErr {
+				require.ErrorIs(t, err, safe.ErrInvalidRune)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expect, result)
+		})
+	}
+
+	_, err := safe.IntToRune(unicode.MaxRune + 1)
+	require.ErrorIs(t, err, safe.ErrValueOutOfRange)
+}
+
+// TestUint32ToRune tests the conversion from uint32 to rune.
+func TestUint32ToRune(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   uint32
+		expect  rune
+		wantErr bool
+	}{
+		{zeroValueName, 0, 0, false},
+		{"ascii", 'A', 'A', false},
+		{"max rune", unicode.MaxRune, unicode.MaxRune, false},
+		{"surrogate", 0xDC00, 0, true},
+		{valueTooLargeName, unicode.MaxRune + 1, 0, true},
+		{maxUint32Name, math.MaxUint32, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := safe.Uint32ToRune(tt.input)
+			if tt.wantErr {
+				require.ErrorIs(t, err, safe.ErrInvalidRune)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expect, result)
+		})
+	}
+}
+
+// TestOpcodeFromInt tests the conversion from small integers to script opcodes.
+func TestOpcodeFromInt(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   int
+		expect  byte
+		wantErr bool
+	}{
+		{"OP_0", 0, 0x00, false},
+		{"OP_1NEGATE", -1, 0x4f, false},
+		{"OP_1", 1, 0x51, false},
+		{"OP_16", 16, 0x60, false},
+		{"minus two", -2, 0, true},
+		{"seventeen", 17, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := safe.OpcodeFromInt(tt.input)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expect, result)
+		})
+	}
+}
@@ -0,0 +1,150 @@
+// Package safeconversiontest provides helpers for testing integer conversions, such as
+// wrappers around the safeconversion package, against a math/big oracle.
+//
+// A conversion is correct when it returns the value unchanged whenever the value is
+// representable in the destination type, and returns an error otherwise:
+//
+//	func TestHeightToUint32(t *testing.T) {
+//		safeconversiontest.CheckConversion(t, HeightToUint32)
+//	}
+//
+//	func FuzzHeightToUint32(f *testing.F) {
+//		safeconversiontest.FuzzConversion(f, HeightToUint32)
+//	}
+package safeconversiontest
+
+import (
+	"math/big"
+	"slices"
+	"testing"
+	"unsafe"
+
+	safeconversion "github.com/bsv-blockchain/go-safe-conversion"
+)
+
+// BoundaryValues returns the values of T where conversions are most likely to go wrong,
+// in ascending order and without duplicates: the bounds of T and their neighbors, -1, 0
+// and 1, and the bounds of every fixed-width integer type and their neighbors when they
+// are representable in T.
+func BoundaryValues[T safeconversion.Integer]() []T {
+	minValue, maxValue := Bounds[T]()
+	one := big.NewInt(1)
+
+	candidates := []*big.Int{
+		minValue, new(big.Int).Add(minValue, one),
+		big.NewInt(-1), big.NewInt(0), one,
+		new(big.Int).Sub(maxValue, one), maxValue,
+	}
+
+	for _, bits := range []uint{8, 16, 32, 64} {
+		for _, signed := range []bool{true, false} {
+			limitMin, limitMax := limits(bits, signed)
+			for _, limit := range []*big.Int{limitMin, limitMax} {
+				candidates = append(candidates, new(big.Int).Sub(limit, one), limit, new(big.Int).Add(limit, one))
+			}
+		}
+	}
+
+	slices.SortFunc(candidates, (*big.Int).Cmp)
+	candidates = slices.CompactFunc(candidates, func(a, b *big.Int) bool { return a.Cmp(b) == 0 })
+
+	values := make([]T, 0, len(candidates))
+	for _, candidate := range candidates {
+		if candidate.Cmp(minValue) >= 0 && candidate.Cmp(maxValue) <= 0 {
+			values = append(values, fromBig[T](candidate))
+		}
+	}
+
+	return values
+}
+
+// CheckConversion checks fn against the math/big oracle for every value returned by
+// BoundaryValues[From].
+func CheckConversion[To, From safeconversion.Integer](t testing.TB, fn func(From) (To, error)) {
+	t.Helper()
+
+	for _, value := range BoundaryValues[From]() {
+		CheckValue(t, fn, value)
+	}
+}
+
+// CheckValue checks fn against the math/big oracle for a single value: fn must return the
+// value unchanged when it is representable in To, and return an error otherwise.
+func CheckValue[To, From safeconversion.Integer](t testing.TB, fn func(From) (To, error), value From) {
+	t.Helper()
+
+	result, err := fn(value)
+	expect := ToBig(value)
+	minValue, maxValue := Bounds[To]()
+
+	if expect.Cmp(minValue) < 0 || expect.Cmp(maxValue) > 0 {
+		if err == nil {
+			t.Errorf("converting %d from %T to %T: expected an error, got %d", value, value, result, result)
+		}
+
+		return
+	}
+
+	if err != nil {
+		t.Errorf("converting %d from %T to %T: unexpected error: %v", value, value, result, err)
+		return
+	}
+
+	if got := ToBig(result); got.Cmp(expect) != 0 {
+		t.Errorf("converting %d from %T to %T: got %s", value, value, result, got)
+	}
+}
+
+// FuzzConversion fuzzes fn against the math/big oracle, seeded with BoundaryValues[From].
+// The fuzzer generates 64-bit patterns that are truncated to From, so that every integer
+// type, including uintptr, can be fuzzed.
+func FuzzConversion[To, From safeconversion.Integer](f *testing.F, fn func(From) (To, error)) {
+	f.Helper()
+
+	for _, value := range BoundaryValues[From]() {
+		f.Add(uint64(value)) //nolint:gosec // the seed is the bit pattern of value, which is truncated back to From
+	}
+
+	f.Fuzz(func(t *testing.T, bits uint64) {
+		CheckValue(t, fn, From(bits))
+	})
+}
+
+// Bounds returns the smallest and largest values of T as *big.Int, the range the oracle
+// checks conversions to T against.
+func Bounds[T safeconversion.Integer]() (*big.Int, *big.Int) {
+	var zero T
+
+	//safeconv:ignore // the size of an integer type is at most 8
+	return limits(uint(unsafe.Sizeof(zero))*8, zero-1 < zero)
+}
+
+// ToBig returns value as a *big.Int.
+func ToBig[T safeconversion.Integer](value T) *big.Int {
+	if value < 0 {
+		return big.NewInt(int64(value))
+	}
+
+	return new(big.Int).SetUint64(uint64(value))
+}
+
+// limits returns the smallest and largest values of an integer type with the given width and signedness.
+func limits(bits uint, signed bool) (*big.Int, *big.Int) {
+	one := big.NewInt(1)
+	if !signed {
+		return new(big.Int), new(big.Int).Sub(new(big.Int).Lsh(one, bits), one)
+	}
+
+	limit := new(big.Int).Lsh(one, bits-1)
+
+	return new(big.Int).Neg(limit), new(big.Int).Sub(limit, one)
+}
+
+// fromBig returns value as T. The value must be representable in T.
+func fromBig[T safeconversion.Integer](value *big.Int) T {
+	if value.Sign() < 0 {
+		return T(value.Int64())
+	}
+
+	return T(value.Uint64())
+}
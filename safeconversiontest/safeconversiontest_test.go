@@ -0,0 +1,129 @@
+package safeconversiontest_test
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	safe "github.com/bsv-blockchain/go-safe-conversion"
+	"github.com/bsv-blockchain/go-safe-conversion/safeconversiontest"
+)
+
+// errTooLarge is returned by the wrappers under test.
+var errTooLarge = errors.New("too large") //nolint:gochecknoglobals // sentinel error for the wrappers under test
+
+// recorder is a testing.TB that records failures instead of failing the test.
+type recorder struct {
+	testing.TB
+
+	failures []string
+}
+
+// Helper implements testing.TB.
+func (r *recorder) Helper() {}
+
+// Errorf implements testing.TB.
+func (r *recorder) Errorf(format string, args ...any) {
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+// TestBoundaryValues tests the boundary values of signed and unsigned types.
+func TestBoundaryValues(t *testing.T) {
+	assert.Equal(t, []int8{math.MinInt8, math.MinInt8 + 1, -1, 0, 1, math.MaxInt8 - 1, math.MaxInt8},
+		safeconversiontest.BoundaryValues[int8]())
+
+	assert.Equal(t, []uint16{
+		0, 1,
+		math.MaxInt8 - 1, math.MaxInt8, math.MaxInt8 + 1,
+		math.MaxUint8 - 1, math.MaxUint8, math.MaxUint8 + 1,
+		math.MaxInt16 - 1, math.MaxInt16, math.MaxInt16 + 1,
+		math.MaxUint16 - 1, math.MaxUint16,
+	}, safeconversiontest.BoundaryValues[uint16]())
+
+	values := safeconversiontest.BoundaryValues[int64]()
+	assert.Contains(t, values, int64(math.MinInt32-1))
+	assert.Contains(t, values, int64(math.MaxUint32+1))
+	assert.Equal(t, int64(math.MinInt64), values[0])
+	assert.Equal(t, int64(math.MaxInt64), values[len(values)-1])
+}
+
+// TestBounds tests the bounds and big.Int conversion of signed and unsigned types.
+func TestBounds(t *testing.T) {
+	minValue, maxValue := safeconversiontest.Bounds[int16]()
+	assert.Equal(t, "-32768", minValue.String())
+	assert.Equal(t, "32767", maxValue.String())
+
+	minValue, maxValue = safeconversiontest.Bounds[uint64]()
+	assert.Equal(t, "0", minValue.String())
+	assert.Equal(t, "18446744073709551615", maxValue.String())
+
+	assert.Equal(t, "-9223372036854775808", safeconversiontest.ToBig(int64(math.MinInt64)).String())
+	assert.Equal(t, "18446744073709551615", safeconversiontest.ToBig(uint64(math.MaxUint64)).String())
+}
+
+// TestCheckConversion tests that correct conversions pass and incorrect conversions are reported.
+func TestCheckConversion(t *testing.T) {
+	tests := []struct {
+		name     string
+		check    func(testing.TB)
+		failures int
+	}{
+		{
+			name:  "checked conversion",
+			check: func(tb testing.TB) { safeconversiontest.CheckConversion(tb, safe.Int64ToUint32) },
+		},
+		{
+			name: "unchecked conversion",
+			check: func(tb testing.TB) {
+				safeconversiontest.CheckConversion(tb, func(v int16) (uint8, error) {
+					//safeconv:ignore // the unchecked conversion under test
+					return uint8(v), nil //nolint:gosec // the unchecked conversion under test
+				})
+			},
+			failures: 9,
+		},
+		{
+			name: "off by one",
+			check: func(tb testing.TB) {
+				safeconversiontest.CheckConversion(tb, func(v uint32) (int32, error) {
+					if v >= math.MaxInt32 {
+						return 0, errTooLarge
+					}
+					//safeconv:ignore // the conversion under test, checked with an off-by-one bound
+					return int32(v), nil //nolint:gosec // see above
+				})
+			},
+			failures: 1,
+		},
+		{
+			name: "wrong result",
+			check: func(tb testing.TB) {
+				safeconversiontest.CheckConversion(tb, func(v uint8) (uint16, error) {
+					return uint16(v) + 1, nil
+				})
+			},
+			failures: 7,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &recorder{TB: t}
+			tt.check(r)
+			assert.Len(t, r.failures, tt.failures, r.failures)
+		})
+	}
+}
+
+// FuzzInt64ToUint32 fuzzes a checked conversion through the harness.
+func FuzzInt64ToUint32(f *testing.F) {
+	safeconversiontest.FuzzConversion(f, safe.Int64ToUint32)
+}
+
+// FuzzUintptrToInt fuzzes a conversion from a type the fuzzer does not support directly.
+func FuzzUintptrToInt(f *testing.F) {
+	safeconversiontest.FuzzConversion(f, safe.UintptrToInt)
+}
@@ -0,0 +1,206 @@
+package safeconversion
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// SQLUint16 is an uint16 that implements sql.Scanner and driver.Valuer with checked conversions.
+type SQLUint16 uint16
+
+// SQLUint32 is an uint32 that implements sql.Scanner and driver.Valuer with checked conversions.
+type SQLUint32 uint32
+
+// SQLUint64 is an uint64 that implements sql.Scanner and driver.Valuer with checked conversions.
+// Values above math.MaxInt64 cannot be written, as driver.Value has no unsigned integer type.
+type SQLUint64 uint64
+
+// NullUint16 represents an uint16 that may be NULL, mirroring sql.NullInt16.
+type NullUint16 struct {
+	Uint16 uint16
+	Valid  bool // Valid is true if Uint16 is not NULL
+}
+
+// NullUint32 represents an uint32 that may be NULL, mirroring sql.NullInt32.
+type NullUint32 struct {
+	Uint32 uint32
+	Valid  bool // Valid is true if Uint32 is not NULL
+}
+
+// NullUint64 represents an uint64 that may be NULL, mirroring sql.NullInt64.
+type NullUint64 struct {
+	Uint64 uint64
+	Valid  bool // Valid is true if Uint64 is not NULL
+}
+
+// Scan implements sql.Scanner.
+func (u *SQLUint16) Scan(src any) error {
+	v, err := scanInteger[uint16](src)
+	if err != nil {
+		return err
+	}
+
+	*u = SQLUint16(v)
+
+	return nil
+}
+
+// Value implements driver.Valuer.
+func (u SQLUint16) Value() (driver.Value, error) {
+	return int64(u), nil
+}
+
+// Scan implements sql.Scanner.
+func (u *SQLUint32) Scan(src any) error {
+	v, err := scanInteger[uint32](src)
+	if err != nil {
+		return err
+	}
+
+	*u = SQLUint32(v)
+
+	return nil
+}
+
+// Value implements driver.Valuer.
+func (u SQLUint32) Value() (driver.Value, error) {
+	return int64(u), nil
+}
+
+// Scan implements sql.Scanner.
+func (u *SQLUint64) Scan(src any) error {
+	v, err := scanInteger[uint64](src)
+	if err != nil {
+		return err
+	}
+
+	*u = SQLUint64(v)
+
+	return nil
+}
+
+// Value implements driver.Valuer.
+// Returns an error if the value exceeds the maximum value of an int64.
+func (u SQLUint64) Value() (driver.Value, error) {
+	return Uint64ToInt64(uint64(u))
+}
+
+// Scan implements sql.Scanner.
+func (n *NullUint16) Scan(src any) error {
+	if src == nil {
+		n.Uint16, n.Valid = 0, false
+		return nil
+	}
+
+	v, err := scanInteger[uint16](src)
+	if err != nil {
+		return err
+	}
+
+	n.Uint16, n.Valid = v, true
+
+	return nil
+}
+
+// Value implements driver.Valuer.
+func (n NullUint16) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil //nolint:nilnil // a nil driver.Value represents NULL
+	}
+
+	return int64(n.Uint16), nil
+}
+
+// Scan implements sql.Scanner.
+func (n *NullUint32) Scan(src any) error {
+	if src == nil {
+		n.Uint32, n.Valid = 0, false
+		return nil
+	}
+
+	v, err := scanInteger[uint32](src)
+	if err != nil {
+		return err
+	}
+
+	n.Uint32, n.Valid = v, true
+
+	return nil
+}
+
+// Value implements driver.Valuer.
+func (n NullUint32) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil //nolint:nilnil // a nil driver.Value represents NULL
+	}
+
+	return int64(n.Uint32), nil
+}
+
+// Scan implements sql.Scanner.
+func (n *NullUint64) Scan(src any) error {
+	if src == nil {
+		n.Uint64, n.Valid = 0, false
+		return nil
+	}
+
+	v, err := scanInteger[uint64](src)
+	if err != nil {
+		return err
+	}
+
+	n.Uint64, n.Valid = v, true
+
+	return nil
+}
+
+// Value implements driver.Valuer.
+// Returns an error if the value exceeds the maximum value of an int64.
+func (n NullUint64) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil //nolint:nilnil // a nil driver.Value represents NULL
+	}
+
+	return Uint64ToInt64(n.Uint64)
+}
+
+// scanInteger converts a value returned by a database/sql driver to T.
+// Supports every integer representation drivers return: int64, uint64, float64, []byte and string.
+// Text is parsed in base 10, as database columns store it, so a leading zero is not an octal prefix.
+func scanInteger[T Integer](src any) (T, error) {
+	var (
+		result  T
+		srcType string
+		value   = src
+		err     error
+	)
+
+	switch v := src.(type) {
+	case int64:
+		result, err = fromInt64[T](v)
+		srcType = "int64"
+	case uint64:
+		result, err = fromUint64[T](v)
+		srcType = "uint64"
+	case float64:
+		result, err = fromFloat64[T](v)
+		srcType = "float64"
+	case []byte:
+		// Drivers may reuse the buffer after Scan returns, so the error keeps a copy.
+		text := string(v)
+		result, err = parseInteger[T](text, 10)
+		value = text
+		srcType = "[]byte"
+	case string:
+		result, err = parseInteger[T](v, 10)
+		srcType = "string"
+	default:
+		return 0, fmt.Errorf("%w for %s: %T", ErrUnsupportedType, typeName[T](), src)
+	}
+
+	if err != nil {
+		return 0, failure(srcType, typeName[T](), value, err)
+	}
+
+	return result, nil
+}
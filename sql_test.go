@@ -0,0 +1,167 @@
+package safeconversion_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	safe "github.com/bsv-blockchain/go-safe-conversion"
+)
+
+var (
+	_ sql.Scanner   = (*safe.SQLUint64)(nil)
+	_ driver.Valuer = safe.SQLUint64(0)
+	_ sql.Scanner   = (*safe.NullUint32)(nil)
+	_ driver.Valuer = safe.NullUint32{}
+)
+
+// TestSQLUint32Scan tests scanning every driver value type into a SQLUint32.
+func TestSQLUint32Scan(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   any
+		expect  safe.SQLUint32
+		wantErr error
+	}{
+		{zeroValueName, int64(0), 0, nil},
+		{positiveValueName, int64(100), 100, nil},
+		{maxUint32Name, int64(math.MaxUint32), math.MaxUint32, nil},
+		{negativeValueName, int64(-1), 0, safe.ErrNegativeValueCannotBeConverted},
+		{valueTooLargeName, int64(math.MaxUint32) + 1, 0, safe.ErrValueOutOfRange},
+		{"uint64 value", uint64(100), 100, nil},
+		{"uint64 too large", uint64(math.MaxUint64), 0, safe.ErrValueOutOfRange},
+		{"float64 value", float64(100), 100, nil},
+		{"float64 fractional", 1.5, 0, safe.ErrFractionalValue},
+		{"float64 negative", float64(-1), 0, safe.ErrNegativeValueCannotBeConverted},
+		{"float64 too large", float64(math.MaxUint32) + 1, 0, safe.ErrValueOutOfRange},
+		{"float64 NaN", math.NaN(), 0, safe.ErrValueOutOfRange},
+		{"float64 infinity", math.Inf(1), 0, safe.ErrValueOutOfRange},
+		{"bytes value", []byte("4294967295"), math.MaxUint32, nil},
+		{"bytes too large", []byte("4294967296"), 0, safe.ErrValueOutOfRange},
+		{"bytes invalid", []byte("abc"), 0, safe.ErrInvalidSyntax},
+		{"string value", "100", 100, nil},
+		{"string negative", "-1", 0, safe.ErrNegativeValueCannotBeConverted},
+		{"string leading zero", "010", 10, nil},
+		{"string plus sign", "+100", 100, nil},
+		{"bytes leading zero", []byte("010"), 10, nil},
+		{"string hex prefix", "0x10", 0, safe.ErrInvalidSyntax},
+		{"bytes hex prefix", []byte("0x10"), 0, safe.ErrInvalidSyntax},
+		{"string underscore", "1_000", 0, safe.ErrInvalidSyntax},
+		{"nil value", nil, 0, safe.ErrUnsupportedType},
+		{"bool value", true, 0, safe.ErrUnsupportedType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var result safe.SQLUint32
+			err := result.Scan(tt.input)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expect, result)
+		})
+	}
+}
+
+// TestSQLUint64Scan tests scanning the full uint64 range into a SQLUint64.
+func TestSQLUint64Scan(t *testing.T) {
+	var result safe.SQLUint64
+	require.NoError(t, result.Scan("18446744073709551615"))
+	assert.Equal(t, safe.SQLUint64(math.MaxUint64), result)
+
+	require.NoError(t, result.Scan(int64(math.MaxInt64)))
+	assert.Equal(t, safe.SQLUint64(math.MaxInt64), result)
+
+	require.ErrorIs(t, result.Scan(int64(-1)), safe.ErrNegativeValueCannotBeConverted)
+}
+
+// TestSQLUint16Scan tests scanning into a SQLUint16.
+func TestSQLUint16Scan(t *testing.T) {
+	var result safe.SQLUint16
+	require.NoError(t, result.Scan(int64(math.MaxUint16)))
+	assert.Equal(t, safe.SQLUint16(math.MaxUint16), result)
+
+	require.ErrorIs(t, result.Scan(int64(math.MaxUint16)+1), safe.ErrValueOutOfRange)
+}
+
+// TestSQLUintValue tests writing unsigned values as driver values.
+func TestSQLUintValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   driver.Valuer
+		expect  driver.Value
+		wantErr error
+	}{
+		{"uint16", safe.SQLUint16(math.MaxUint16), int64(math.MaxUint16), nil},
+		{"uint32", safe.SQLUint32(math.MaxUint32), int64(math.MaxUint32), nil},
+		{"uint64 max int64", safe.SQLUint64(math.MaxInt64), int64(math.MaxInt64), nil},
+		{"uint64 too large", safe.SQLUint64(math.MaxInt64 + 1), nil, safe.ErrValueOutOfRange},
+		{"null uint16 valid", safe.NullUint16{Uint16: 1, Valid: true}, int64(1), nil},
+		{"null uint16 invalid", safe.NullUint16{Uint16: 1}, nil, nil},
+		{"null uint32 valid", safe.NullUint32{Uint32: 1, Valid: true}, int64(1), nil},
+		{"null uint32 invalid", safe.NullUint32{Uint32: 1}, nil, nil},
+		{"null uint64 valid", safe.NullUint64{Uint64: 1, Valid: true}, int64(1), nil},
+		{"null uint64 invalid", safe.NullUint64{Uint64: 1}, nil, nil},
+		{"null uint64 too large", safe.NullUint64{Uint64: math.MaxUint64, Valid: true}, nil, safe.ErrValueOutOfRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.input.Value()
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expect, result)
+		})
+	}
+}
+
+// TestNullUintScan tests scanning NULL and non-NULL values into the nullable types.
+func TestNullUintScan(t *testing.T) {
+	t.Run("uint16", func(t *testing.T) {
+		n := safe.NullUint16{Uint16: 5, Valid: true}
+		require.NoError(t, n.Scan(nil))
+		assert.Equal(t, safe.NullUint16{}, n)
+
+		require.NoError(t, n.Scan(int64(7)))
+		assert.Equal(t, safe.NullUint16{Uint16: 7, Valid: true}, n)
+
+		require.ErrorIs(t, n.Scan(int64(-1)), safe.ErrNegativeValueCannotBeConverted)
+	})
+
+	t.Run("uint32", func(t *testing.T) {
+		n := safe.NullUint32{Uint32: 5, Valid: true}
+		require.NoError(t, n.Scan(nil))
+		assert.Equal(t, safe.NullUint32{}, n)
+
+		require.NoError(t, n.Scan([]byte("800000")))
+		assert.Equal(t, safe.NullUint32{Uint32: 800000, Valid: true}, n)
+
+		require.NoError(t, n.Scan("010"))
+		assert.Equal(t, safe.NullUint32{Uint32: 10, Valid: true}, n)
+
+		require.ErrorIs(t, n.Scan([]byte("0x10")), safe.ErrInvalidSyntax)
+		require.ErrorIs(t, n.Scan(int64(math.MaxUint32)+1), safe.ErrValueOutOfRange)
+	})
+
+	t.Run("uint64", func(t *testing.T) {
+		n := safe.NullUint64{Uint64: 5, Valid: true}
+		require.NoError(t, n.Scan(nil))
+		assert.Equal(t, safe.NullUint64{}, n)
+
+		require.NoError(t, n.Scan(int64(2100000000000000)))
+		assert.Equal(t, safe.NullUint64{Uint64: 2100000000000000, Valid: true}, n)
+
+		require.ErrorIs(t, n.Scan(float64(-1)), safe.ErrNegativeValueCannotBeConverted)
+	})
+}
@@ -0,0 +1,283 @@
+package safeconversion
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"math/bits"
+)
+
+// Uint256 is an unsigned 256-bit integer for hashes, targets and cumulative chain work,
+// stored as four 64-bit limbs with the least significant limb first. It avoids the
+// allocations of *big.Int, and all arithmetic is checked and fails with ErrValueOverflow
+// instead of wrapping.
+type Uint256 [4]uint64
+
+// compact format fields used by Uint256FromCompact and Uint256.Compact.
+const (
+	compactSignBit  = 0x00800000
+	compactMantissa = 0x007fffff
+)
+
+// NewUint256 converts any integer to an Uint256.
+// Returns an error if the value is negative.
+func NewUint256[T Integer](value T) (Uint256, error) {
+	if value < 0 {
+		return Uint256{}, failure(typeName[T](), "uint256", value, fmt.Errorf("%w to uint256: %d", ErrNegativeValueCannotBeConverted, value))
+	}
+
+	return Uint256{uint64(value)}, nil
+}
+
+// Uint256FromBig converts a *big.Int to an Uint256.
+// Returns an error if the value is nil, negative or wider than 256 bits.
+func Uint256FromBig(value *big.Int) (Uint256, error) {
+	if value == nil {
+		return Uint256{}, fmt.Errorf("%w for uint256: nil *big.Int", ErrUnsupportedType)
+	}
+
+	if value.Sign() < 0 {
+		return Uint256{}, failure("*big.Int", "uint256", bigCopy(value), fmt.Errorf("%w to uint256: %s", ErrNegativeValueCannotBeConverted, value))
+	}
+
+	if value.BitLen() > 256 {
+		return Uint256{}, failure("*big.Int", "uint256", bigCopy(value), fmt.Errorf("uint256 %w: %s", ErrValueOverflow, value))
+	}
+
+	var buf [32]byte
+	value.FillBytes(buf[:])
+
+	return Uint256FromBytesBE(buf), nil
+}
+
+// Uint256FromBytesBE converts a 32-byte big-endian value to an Uint256. The conversion is always safe.
+func Uint256FromBytesBE(b [32]byte) Uint256 {
+	return Uint256{
+		binary.BigEndian.Uint64(b[24:]),
+		binary.BigEndian.Uint64(b[16:24]),
+		binary.BigEndian.Uint64(b[8:16]),
+		binary.BigEndian.Uint64(b[:8]),
+	}
+}
+
+// Uint256FromBytesLE converts a 32-byte little-endian value, such as a hash in its wire
+// byte order, to an Uint256. The conversion is always safe.
+func Uint256FromBytesLE(b [32]byte) Uint256 {
+	return Uint256{
+		binary.LittleEndian.Uint64(b[:8]),
+		binary.LittleEndian.Uint64(b[8:16]),
+		binary.LittleEndian.Uint64(b[16:24]),
+		binary.LittleEndian.Uint64(b[24:]),
+	}
+}
+
+// Uint256FromCompact converts a compact "nBits" target representation to an Uint256.
+// Returns an error if the sign bit is set on a non-zero mantissa, or if the encoded value
+// is wider than 256 bits.
+func Uint256FromCompact(compact uint32) (Uint256, error) {
+	exponent := uint(compact >> 24)
+	mantissa := uint64(compact & compactMantissa)
+
+	if mantissa != 0 && compact&compactSignBit != 0 {
+		return Uint256{}, failure("compact", "uint256", compact, fmt.Errorf("%w to uint256: compact %#08x", ErrNegativeValueCannotBeConverted, compact))
+	}
+
+	if exponent <= 3 {
+		return Uint256{mantissa >> (8 * (3 - exponent))}, nil
+	}
+
+	if mantissa != 0 && (exponent > 34 || (mantissa > 0xff && exponent > 33) || (mantissa > 0xffff && exponent > 32)) {
+		return Uint256{}, failure("compact", "uint256", compact, fmt.Errorf("uint256 %w: compact %#08x", ErrValueOverflow, compact))
+	}
+
+	return Uint256{mantissa}.lsh(8 * (exponent - 3)), nil
+}
+
+// Uint64 converts the value to an uint64.
+// Returns an error if the value exceeds the maximum value of an uint64.
+func (u Uint256) Uint64() (uint64, error) {
+	if u[1] != 0 || u[2] != 0 || u[3] != 0 {
+		return 0, failure("uint256", "uint64", u, fmt.Errorf("uint64 %w: %s", ErrValueOverflow, u))
+	}
+
+	return u[0], nil
+}
+
+// Big returns the value as a *big.Int.
+func (u Uint256) Big() *big.Int {
+	b := u.BytesBE()
+	return new(big.Int).SetBytes(b[:])
+}
+
+// BytesBE returns the value as 32 big-endian bytes.
+func (u Uint256) BytesBE() [32]byte {
+	var b [32]byte
+	binary.BigEndian.PutUint64(b[:8], u[3])
+	binary.BigEndian.PutUint64(b[8:16], u[2])
+	binary.BigEndian.PutUint64(b[16:24], u[1])
+	binary.BigEndian.PutUint64(b[24:], u[0])
+
+	return b
+}
+
+// BytesLE returns the value as 32 little-endian bytes.
+func (u Uint256) BytesLE() [32]byte {
+	var b [32]byte
+	binary.LittleEndian.PutUint64(b[:8], u[0])
+	binary.LittleEndian.PutUint64(b[8:16], u[1])
+	binary.LittleEndian.PutUint64(b[16:24], u[2])
+	binary.LittleEndian.PutUint64(b[24:], u[3])
+
+	return b
+}
+
+// Compact returns the value in the compact "nBits" representation, truncating the
+// mantissa to its three most significant bytes.
+func (u Uint256) Compact() uint32 {
+	size := uint((u.BitLen() + 7) / 8)
+
+	var mantissa uint64
+	if size <= 3 {
+		mantissa = u[0] << (8 * (3 - size))
+	} else {
+		mantissa = u.rsh(8 * (size - 3))[0]
+	}
+
+	// The sign bit is reserved, so a mantissa with it set is shifted into the next exponent.
+	if mantissa&compactSignBit != 0 {
+		mantissa >>= 8
+		size++
+	}
+
+	return uint32(mantissa) | uint32(size)<<24 //nolint:gosec // mantissa < 1<<24 and size <= 33
+}
+
+// BitLen returns the number of bits required to represent the value.
+func (u Uint256) BitLen() int {
+	for i := 3; i >= 0; i-- {
+		if u[i] != 0 {
+			return i*64 + bits.Len64(u[i])
+		}
+	}
+
+	return 0
+}
+
+// IsZero reports whether the value is zero.
+func (u Uint256) IsZero() bool {
+	return u == Uint256{}
+}
+
+// Cmp compares u and v and returns -1 if u < v, 0 if u == v, and +1 if u > v.
+func (u Uint256) Cmp(v Uint256) int {
+	for i := 3; i >= 0; i-- {
+		switch {
+		case u[i] < v[i]:
+			return -1
+		case u[i] > v[i]:
+			return 1
+		}
+	}
+
+	return 0
+}
+
+// Add returns u + v.
+// Returns an error if the result overflows 256 bits.
+func (u Uint256) Add(v Uint256) (Uint256, error) {
+	var result Uint256
+	var carry uint64
+
+	for i := range u {
+		result[i], carry = bits.Add64(u[i], v[i], carry)
+	}
+
+	if carry != 0 {
+		return Uint256{}, fmt.Errorf("uint256 %w: %s + %s", ErrValueOverflow, u, v)
+	}
+
+	return result, nil
+}
+
+// Sub returns u - v.
+// Returns an error if v is greater than u.
+func (u Uint256) Sub(v Uint256) (Uint256, error) {
+	var result Uint256
+	var borrow uint64
+
+	for i := range u {
+		result[i], borrow = bits.Sub64(u[i], v[i], borrow)
+	}
+
+	if borrow != 0 {
+		return Uint256{}, fmt.Errorf("uint256 %w: %s - %s", ErrValueOverflow, u, v)
+	}
+
+	return result, nil
+}
+
+// Mul returns u * v.
+// Returns an error if the result overflows 256 bits.
+func (u Uint256) Mul(v Uint256) (Uint256, error) {
+	var product [8]uint64
+
+	for i := range u {
+		var carry uint64
+
+		for j := range v {
+			hi, lo := bits.Mul64(u[i], v[j])
+
+			var c uint64
+			lo, c = bits.Add64(lo, product[i+j], 0)
+			hi += c
+			lo, c = bits.Add64(lo, carry, 0)
+			hi += c
+
+			product[i+j] = lo
+			carry = hi
+		}
+
+		product[i+4] = carry
+	}
+
+	if product[4] != 0 || product[5] != 0 || product[6] != 0 || product[7] != 0 {
+		return Uint256{}, fmt.Errorf("uint256 %w: %s * %s", ErrValueOverflow, u, v)
+	}
+
+	return Uint256{product[0], product[1], product[2], product[3]}, nil
+}
+
+// String returns the value in base 10.
+func (u Uint256) String() string {
+	return u.Big().String()
+}
+
+// lsh returns u shifted left by n bits, discarding bits shifted out.
+func (u Uint256) lsh(n uint) Uint256 {
+	var result Uint256
+
+	limbs, shift := int(n/64), n%64
+	for i := 3; i >= limbs; i-- {
+		result[i] = u[i-limbs] << shift
+		if shift != 0 && i-limbs > 0 {
+			result[i] |= u[i-limbs-1] >> (64 - shift)
+		}
+	}
+
+	return result
+}
+
+// rsh returns u shifted right by n bits.
+func (u Uint256) rsh(n uint) Uint256 {
+	var result Uint256
+
+	limbs, shift := int(n/64), n%64
+	for i := 0; i+limbs <= 3; i++ {
+		result[i] = u[i+limbs] >> shift
+		if shift != 0 && i+limbs < 3 {
+			result[i] |= u[i+limbs+1] << (64 - shift)
+		}
+	}
+
+	return result
+}
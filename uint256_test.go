@@ -0,0 +1,219 @@
+package safeconversion_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	safe "github.com/bsv-blockchain/go-safe-conversion"
+)
+
+// maxUint256 returns the largest Uint256.
+func maxUint256() safe.Uint256 {
+	return safe.Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+}
+
+// TestNewUint256 tests converting native integers to Uint256.
+func TestNewUint256(t *testing.T) {
+	u, err := safe.NewUint256(uint64(math.MaxUint64))
+	require.NoError(t, err)
+	assert.Equal(t, safe.Uint256{math.MaxUint64}, u)
+
+	v, err := u.Uint64()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(math.MaxUint64), v)
+
+	_, err = safe.NewUint256(int32(-1))
+	require.ErrorIs(t, err, safe.ErrNegativeValueCannotBeConverted)
+
+	_, err = safe.Uint256{0, 1}.Uint64()
+	require.ErrorIs(t, err, safe.ErrValueOverflow)
+}
+
+// TestUint256Big tests converting between Uint256 and *big.Int.
+func TestUint256Big(t *testing.T) {
+	maxValue := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+	tests := []struct {
+		name    string
+		input   *big.Int
+		expect  safe.Uint256
+		wantErr error
+	}{
+		{zeroValueName, big.NewInt(0), safe.Uint256{}, nil},
+		{maxUint64Name, new(big.Int).SetUint64(math.MaxUint64), safe.Uint256{math.MaxUint64}, nil},
+		{"2^128", new(big.Int).Lsh(big.NewInt(1), 128), safe.Uint256{0, 0, 1}, nil},
+		{"max uint256", maxValue, maxUint256(), nil},
+		{valueTooLargeName, new(big.Int).Lsh(big.NewInt(1), 256), safe.Uint256{}, safe.ErrValueOverflow},
+		{negativeValueName, big.NewInt(-1), safe.Uint256{}, safe.ErrNegativeValueCannotBeConverted},
+		{"nil value", nil, safe.Uint256{}, safe.ErrUnsupportedType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := safe.Uint256FromBig(tt.input)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expect, result)
+			assert.Equal(t, 0, tt.input.Cmp(result.Big()))
+			assert.Equal(t, tt.input.String(), result.String())
+		})
+	}
+}
+
+// TestUint256Bytes tests converting between Uint256 and 32-byte arrays.
+func TestUint256Bytes(t *testing.T) {
+	var be [32]byte
+	for i := range be {
+		be[i] = byte(i + 1)
+	}
+
+	u := safe.Uint256FromBytesBE(be)
+	assert.Equal(t, uint64(0x191a1b1c1d1e1f20), u[0])
+	assert.Equal(t, uint64(0x0102030405060708), u[3])
+	assert.Equal(t, be, u.BytesBE())
+
+	var le [32]byte
+	for i := range le {
+		le[i] = be[31-i]
+	}
+
+	assert.Equal(t, u, safe.Uint256FromBytesLE(le))
+	assert.Equal(t, le, u.BytesLE())
+}
+
+// TestUint256Compact tests converting between Uint256 and the compact nBits representation.
+func TestUint256Compact(t *testing.T) {
+	tests := []struct {
+		name      string
+		compact   uint32
+		expect    string
+		roundTrip uint32
+		wantErr   error
+	}{
+		{zeroValueName, 0x00000000, "0", 0x00000000, nil},
+		{"exponent one truncated", 0x01003456, "0", 0x00000000, nil},
+		{"exponent one", 0x01123456, "18", 0x01120000, nil},
+		{"exponent two", 0x02123456, "4660", 0x02123400, nil},
+		{"exponent three", 0x03123456, "1193046", 0x03123456, nil},
+		{"exponent four", 0x04123456, "305419776", 0x04123456, nil},
+		{"shifted mantissa", 0x05009234, "2452881408", 0x05009234, nil},
+		{"genesis target", 0x1d00ffff, "26959535291011309493156476344723991336010898738574164086137773096960", 0x1d00ffff, nil},
+		{"max exponent", 0x20123456, "8234100872053094964343885742466156107944985754811990998090428875106854895616", 0x20123456, nil},
+		{"negative mantissa", 0x04923456, "", 0, safe.ErrNegativeValueCannotBeConverted},
+		{"negative zero", 0x01800000, "0", 0x00000000, nil},
+		{"overflow", 0xff123456, "", 0, safe.ErrValueOverflow},
+		{"overflow exponent 34", 0x22000100, "", 0, safe.ErrValueOverflow},
+		{"zero mantissa large exponent", 0xff000000, "0", 0x00000000, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := safe.Uint256FromCompact(tt.compact)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expect, result.String())
+			assert.Equal(t, tt.roundTrip, result.Compact())
+		})
+	}
+
+	assert.Equal(t, uint32(0x02008000), safe.Uint256{0x80}.Compact())
+	assert.Equal(t, uint32(0x21008000), safe.Uint256{0, 0, 0, 1 << 63}.Compact())
+}
+
+// TestUint256Arithmetic tests checked Uint256 arithmetic.
+func TestUint256Arithmetic(t *testing.T) {
+	sum, err := safe.Uint256{math.MaxUint64, math.MaxUint64}.Add(safe.Uint256{1})
+	require.NoError(t, err)
+	assert.Equal(t, safe.Uint256{0, 0, 1}, sum)
+
+	_, err = maxUint256().Add(safe.Uint256{1})
+	require.ErrorIs(t, err, safe.ErrValueOverflow)
+
+	diff, err := safe.Uint256{0, 0, 1}.Sub(safe.Uint256{1})
+	require.NoError(t, err)
+	assert.Equal(t, safe.Uint256{math.MaxUint64, math.MaxUint64}, diff)
+
+	_, err = safe.Uint256{}.Sub(safe.Uint256{1})
+	require.ErrorIs(t, err, safe.ErrValueOverflow)
+
+	product, err := safe.Uint256{0, 1}.Mul(safe.Uint256{0, 0, 1})
+	require.NoError(t, err)
+	assert.Equal(t, safe.Uint256{0, 0, 0, 1}, product)
+
+	_, err = safe.Uint256{0, 0, 1}.Mul(safe.Uint256{0, 0, 1})
+	require.ErrorIs(t, err, safe.ErrValueOverflow)
+
+	assert.Equal(t, -1, safe.Uint256{math.MaxUint64}.Cmp(safe.Uint256{0, 1}))
+	assert.Equal(t, 1, maxUint256().Cmp(safe.Uint256{}))
+	assert.Equal(t, 0, maxUint256().Cmp(maxUint256()))
+	assert.True(t, safe.Uint256{}.IsZero())
+	assert.Equal(t, 256, maxUint256().BitLen())
+	assert.Equal(t, 0, safe.Uint256{}.BitLen())
+}
+
+// FuzzUint256Arithmetic validates Uint256 arithmetic against math/big.
+func FuzzUint256Arithmetic(f *testing.F) {
+	f.Add([]byte{}, []byte{})
+	f.Add([]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, []byte{1})
+	f.Add([]byte{1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, []byte{1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0})
+	f.Fuzz(func(t *testing.T, aBytes, bBytes []byte) {
+		if len(aBytes) > 32 || len(bBytes) > 32 {
+			return
+		}
+		aBig, bBig := new(big.Int).SetBytes(aBytes), new(big.Int).SetBytes(bBytes)
+		a, err := safe.Uint256FromBig(aBig)
+		require.NoError(t, err)
+		b, err := safe.Uint256FromBig(bBig)
+		require.NoError(t, err)
+
+		ops := []struct {
+			name   string
+			fn     func(safe.Uint256) (safe.Uint256, error)
+			expect *big.Int
+		}{
+			{"add", a.Add, new(big.Int).Add(aBig, bBig)},
+			{"sub", a.Sub, new(big.Int).Sub(aBig, bBig)},
+			{"mul", a.Mul, new(big.Int).Mul(aBig, bBig)},
+		}
+
+		for _, op := range ops {
+			result, err := op.fn(b)
+			if _, rangeErr := safe.Uint256FromBig(op.expect); rangeErr != nil {
+				require.ErrorIs(t, err, safe.ErrValueOverflow, op.name)
+				continue
+			}
+			require.NoError(t, err, op.name)
+			assert.Equal(t, op.expect.String(), result.String(), op.name)
+		}
+		assert.Equal(t, aBig.Cmp(bBig), a.Cmp(b))
+		assert.Equal(t, aBig.BitLen(), a.BitLen())
+	})
+}
+
+// FuzzUint256Compact validates that compact encoding round trips through Uint256FromCompact.
+func FuzzUint256Compact(f *testing.F) {
+	f.Add(uint32(0x1d00ffff))
+	f.Add(uint32(0x20123456))
+	f.Add(uint32(0x04923456))
+	f.Fuzz(func(t *testing.T, compact uint32) {
+		u, err := safe.Uint256FromCompact(compact)
+		if err != nil {
+			return
+		}
+		decoded, err := safe.Uint256FromCompact(u.Compact())
+		require.NoError(t, err)
+		assert.Equal(t, u, decoded)
+	})
+}